@@ -37,8 +37,13 @@ var _ provider.ProviderWithEphemeralResources = &azureRmFrameworkProvider{}
 
 func (p *azureRmFrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
+		providerfunction.NewBuildRoleAssignmentConditionFunction,
+		providerfunction.NewEncodePrometheusRuleGroupsFunction,
+		providerfunction.NewEvaluatePolicyRuleFunction,
 		providerfunction.NewNormaliseResourceIDFunction,
+		providerfunction.NewParsePrometheusRuleGroupsFunction,
 		providerfunction.NewParseResourceIDFunction,
+		providerfunction.NewParseRoleAssignmentConditionFunction,
 	}
 }
 
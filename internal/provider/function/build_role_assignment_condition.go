@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BuildRoleAssignmentConditionFunction struct{}
+
+var _ function.Function = BuildRoleAssignmentConditionFunction{}
+
+func NewBuildRoleAssignmentConditionFunction() function.Function {
+	return &BuildRoleAssignmentConditionFunction{}
+}
+
+func (b BuildRoleAssignmentConditionFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "build_role_assignment_condition"
+}
+
+func (b BuildRoleAssignmentConditionFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "build_role_assignment_condition",
+		Description:         "Builds and validates a Role Assignment condition from a list of objects, each describing the actions, attribute, operator and values of a condition",
+		MarkdownDescription: "Builds and validates a Role Assignment condition from a list of objects, each describing the `actions`, `attribute`, `operator` and `values` of a condition",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "conditions",
+				Description:         "The conditions, which are combined with AND",
+				MarkdownDescription: "The conditions, which are combined with `AND`",
+				ElementType: types.ObjectType{
+					AttrTypes: roleAssignmentConditionAttrTypes,
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (b BuildRoleAssignmentConditionFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var conditions []roleAssignmentCondition
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &conditions))
+
+	if response.Error != nil {
+		return
+	}
+
+	result, err := buildRoleAssignmentCondition(conditions)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionBuildRoleAssignmentCondition_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testBuildRoleAssignmentConditionRoundTrip(),
+				Check: acceptance.ComposeTestCheckFunc(
+					resource.TestCheckOutput("condition", "(\n (\n  !(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})\n )\n OR\n (\n  @Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] ForAnyOfAnyValues:StringEquals {'logs', 'audit'}\n )\n)"),
+					resource.TestCheckOutput("attribute", "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name]"),
+					resource.TestCheckOutput("operator", "ForAnyOfAnyValues:StringEquals"),
+					resource.TestCheckOutput("last_value", "audit"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionBuildRoleAssignmentCondition_invalidOperator(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testBuildRoleAssignmentConditionInvalidOperator(),
+				ExpectError: regexp.MustCompile("operator"),
+			},
+		},
+	})
+}
+
+func testBuildRoleAssignmentConditionRoundTrip() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  condition = provider::azurerm::build_role_assignment_condition([
+    {
+      actions   = ["Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"]
+      attribute = "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name]"
+      operator  = "ForAnyOfAnyValues:StringEquals"
+      values    = ["logs", "audit"]
+    },
+  ])
+  parsed = provider::azurerm::parse_role_assignment_condition(local.condition)
+}
+
+output "condition" {
+  value = local.condition
+}
+
+output "attribute" {
+  value = local.parsed[0].attribute
+}
+
+output "operator" {
+  value = local.parsed[0].operator
+}
+
+output "last_value" {
+  value = local.parsed[0].values[1]
+}
+`
+}
+
+func testBuildRoleAssignmentConditionInvalidOperator() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "condition" {
+  value = provider::azurerm::build_role_assignment_condition([
+    {
+      actions   = ["Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"]
+      attribute = "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name]"
+      operator  = "StringContains"
+      values    = ["logs"]
+    },
+  ])
+}
+`
+}
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type EncodePrometheusRuleGroupsFunction struct{}
+
+var _ function.Function = EncodePrometheusRuleGroupsFunction{}
+
+func NewEncodePrometheusRuleGroupsFunction() function.Function {
+	return &EncodePrometheusRuleGroupsFunction{}
+}
+
+func (e EncodePrometheusRuleGroupsFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "encode_prometheus_rule_groups"
+}
+
+func (e EncodePrometheusRuleGroupsFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "encode_prometheus_rule_groups",
+		Description:         "Encodes a list of rule groups using the schema of the azurerm_monitor_alert_prometheus_rule_group resource as a Prometheus rule file",
+		MarkdownDescription: "Encodes a list of rule groups using the schema of the `azurerm_monitor_alert_prometheus_rule_group` resource as a Prometheus rule file",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "rule_groups",
+				Description:         "A list of rule groups, each containing a name, an optional interval and a list of rules",
+				MarkdownDescription: "A list of rule groups, each containing a `name`, an optional `interval` and a list of `rule`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (e EncodePrometheusRuleGroupsFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var input types.Dynamic
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &input))
+
+	if response.Error != nil {
+		return
+	}
+
+	groups, err := prometheusRuleGroupsFromValue(prometheusRuleValueToInterface(input))
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result, err := encodePrometheusRuleGroups(groups)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
+
+// prometheusRuleValueToInterface converts a Terraform value into the equivalent Go value, which allows the rule groups to be
+// specified as any combination of lists, tuples, objects and maps
+func prometheusRuleValueToInterface(input attr.Value) interface{} {
+	if input == nil || input.IsNull() || input.IsUnknown() {
+		return nil
+	}
+
+	switch v := input.(type) {
+	case types.Dynamic:
+		return prometheusRuleValueToInterface(v.UnderlyingValue())
+	case types.String:
+		return v.ValueString()
+	case types.Bool:
+		return v.ValueBool()
+	case types.Number:
+		return v.ValueBigFloat().String()
+	case types.Object:
+		return prometheusRuleAttributesToInterface(v.Attributes())
+	case types.Map:
+		return prometheusRuleAttributesToInterface(v.Elements())
+	case types.List:
+		return prometheusRuleElementsToInterface(v.Elements())
+	case types.Set:
+		return prometheusRuleElementsToInterface(v.Elements())
+	case types.Tuple:
+		return prometheusRuleElementsToInterface(v.Elements())
+	}
+
+	return nil
+}
+
+func prometheusRuleAttributesToInterface(input map[string]attr.Value) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		output[k] = prometheusRuleValueToInterface(v)
+	}
+	return output
+}
+
+func prometheusRuleElementsToInterface(input []attr.Value) []interface{} {
+	output := make([]interface{}, 0, len(input))
+	for _, v := range input {
+		output = append(output, prometheusRuleValueToInterface(v))
+	}
+	return output
+}
+
+// prometheusRuleGroupsFromValue reads the rule groups from either a single rule group or a list of rule groups, fields which
+// are specific to Azure Monitor such as `action` and `severity` are ignored since they can't be represented in a rule file
+func prometheusRuleGroupsFromValue(input interface{}) ([]prometheusRuleGroup, error) {
+	var raw []interface{}
+	switch v := input.(type) {
+	case []interface{}:
+		raw = v
+	case map[string]interface{}:
+		raw = []interface{}{v}
+	default:
+		return nil, fmt.Errorf("`rule_groups` must be a list of rule groups")
+	}
+
+	groups := make([]prometheusRuleGroup, 0, len(raw))
+	for i, item := range raw {
+		values, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("rule group %d must be an object", i)
+		}
+
+		group := prometheusRuleGroup{
+			Name:     prometheusRuleStringFromValue(values["name"]),
+			Interval: prometheusRuleStringFromValue(values["interval"]),
+		}
+
+		rules := values["rule"]
+		if rules == nil {
+			rules = values["rules"]
+		}
+		ruleList, ok := rules.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("rule group %d must contain a list of `rule`", i)
+		}
+
+		for j, r := range ruleList {
+			rule, ok := r.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("rule %d of rule group %d must be an object", j, i)
+			}
+
+			expression := rule["expression"]
+			if expression == nil {
+				expression = rule["expr"]
+			}
+
+			group.Rules = append(group.Rules, prometheusRule{
+				Alert:       prometheusRuleStringFromValue(rule["alert"]),
+				Record:      prometheusRuleStringFromValue(rule["record"]),
+				Expression:  prometheusRuleStringFromValue(expression),
+				For:         prometheusRuleStringFromValue(rule["for"]),
+				Labels:      prometheusRuleStringMapFromValue(rule["labels"]),
+				Annotations: prometheusRuleStringMapFromValue(rule["annotations"]),
+			})
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+func prometheusRuleStringFromValue(input interface{}) string {
+	if input == nil {
+		return ""
+	}
+	if v, ok := input.(string); ok {
+		return v
+	}
+	return fmt.Sprintf("%v", input)
+}
+
+func prometheusRuleStringMapFromValue(input interface{}) map[string]string {
+	values, ok := input.(map[string]interface{})
+	if !ok || len(values) == 0 {
+		return nil
+	}
+
+	output := make(map[string]string, len(values))
+	for k, v := range values {
+		output[k] = prometheusRuleStringFromValue(v)
+	}
+	return output
+}
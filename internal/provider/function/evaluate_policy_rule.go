@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type EvaluatePolicyRuleFunction struct{}
+
+var _ function.Function = EvaluatePolicyRuleFunction{}
+
+func NewEvaluatePolicyRuleFunction() function.Function {
+	return &EvaluatePolicyRuleFunction{}
+}
+
+func (e EvaluatePolicyRuleFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "evaluate_policy_rule"
+}
+
+func (e EvaluatePolicyRuleFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "evaluate_policy_rule",
+		Description:         "Evaluates an Azure Policy rule against a resource document and returns the effect when the rule matches, or an empty string when it doesn't",
+		MarkdownDescription: "Evaluates an Azure Policy rule against a resource document and returns the effect when the rule matches, or an empty string when it doesn't",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy_rule",
+				Description:         "The JSON encoded Policy Rule or Policy Definition",
+				MarkdownDescription: "The JSON encoded Policy Rule or Policy Definition",
+			},
+			function.StringParameter{
+				Name:                "resource",
+				Description:         "The JSON encoded resource to evaluate the Policy Rule against",
+				MarkdownDescription: "The JSON encoded resource to evaluate the Policy Rule against",
+			},
+			function.StringParameter{
+				Name:                "parameters",
+				Description:         "The JSON encoded values of the Policy parameters, or null to use the default values",
+				MarkdownDescription: "The JSON encoded values of the Policy parameters, or `null` to use the default values",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (e EvaluatePolicyRuleFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var policyRule, resource string
+	var parameters types.String
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &policyRule, &resource, &parameters))
+
+	if response.Error != nil {
+		return
+	}
+
+	result, err := evaluatePolicyRule(policyRule, resource, parameters.ValueString())
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
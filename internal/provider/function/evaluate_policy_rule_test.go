@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionEvaluatePolicyRule_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testEvaluatePolicyRuleBasic(),
+				Check: acceptance.ComposeTestCheckFunc(
+					resource.TestCheckOutput("non_compliant", "Deny"),
+					resource.TestCheckOutput("compliant", ""),
+					resource.TestCheckOutput("parameters", "Audit"),
+				),
+			},
+		},
+	})
+}
+
+func testEvaluatePolicyRuleBasic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  policy_rule = jsonencode({
+    if = {
+      allOf = [
+        {
+          field  = "type"
+          equals = "Microsoft.Storage/storageAccounts"
+        },
+        {
+          field     = "Microsoft.Storage/storageAccounts/minimumTlsVersion"
+          notEquals = "TLS1_2"
+        },
+      ]
+    }
+    then = {
+      effect = "[parameters('effect')]"
+    }
+  })
+}
+
+output "non_compliant" {
+  value = provider::azurerm::evaluate_policy_rule(local.policy_rule, jsonencode({
+    type = "Microsoft.Storage/storageAccounts"
+    properties = {
+      minimumTlsVersion = "TLS1_0"
+    }
+  }), jsonencode({ effect = "Deny" }))
+}
+
+output "compliant" {
+  value = provider::azurerm::evaluate_policy_rule(local.policy_rule, jsonencode({
+    type = "Microsoft.Storage/storageAccounts"
+    properties = {
+      minimumTlsVersion = "TLS1_2"
+    }
+  }), jsonencode({ effect = "Deny" }))
+}
+
+output "parameters" {
+  value = provider::azurerm::evaluate_policy_rule(jsonencode({
+    parameters = {
+      effect = {
+        type         = "String"
+        defaultValue = "Audit"
+      }
+    }
+    policyRule = jsondecode(local.policy_rule)
+  }), jsonencode({
+    type = "Microsoft.Storage/storageAccounts"
+    properties = {}
+  }), null)
+}
+`
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ParsePrometheusRuleGroupsFunction struct{}
+
+var _ function.Function = ParsePrometheusRuleGroupsFunction{}
+
+var prometheusRuleTypes = map[string]attr.Type{
+	"alert":       types.StringType,
+	"record":      types.StringType,
+	"expression":  types.StringType,
+	"for":         types.StringType,
+	"labels":      types.MapType{}.WithElementType(types.StringType),
+	"annotations": types.MapType{}.WithElementType(types.StringType),
+}
+
+var prometheusRuleGroupTypes = map[string]attr.Type{
+	"name":     types.StringType,
+	"interval": types.StringType,
+	"rule":     types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(prometheusRuleTypes)),
+}
+
+func NewParsePrometheusRuleGroupsFunction() function.Function {
+	return &ParsePrometheusRuleGroupsFunction{}
+}
+
+func (p ParsePrometheusRuleGroupsFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "parse_prometheus_rule_groups"
+}
+
+func (p ParsePrometheusRuleGroupsFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "parse_prometheus_rule_groups",
+		Description:         "Parses a Prometheus rule file into a list of rule groups matching the schema of the azurerm_monitor_alert_prometheus_rule_group resource",
+		MarkdownDescription: "Parses a Prometheus rule file into a list of rule groups matching the schema of the `azurerm_monitor_alert_prometheus_rule_group` resource",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "rules_yaml",
+				Description:         "The contents of the Prometheus rule file",
+				MarkdownDescription: "The contents of the Prometheus rule file",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{}.WithAttributeTypes(prometheusRuleGroupTypes),
+		},
+	}
+}
+
+func (p ParsePrometheusRuleGroupsFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var input string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &input))
+
+	if response.Error != nil {
+		return
+	}
+
+	groups, err := parsePrometheusRuleGroups(input)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	ruleType := types.ObjectType{}.WithAttributeTypes(prometheusRuleTypes)
+	groupType := types.ObjectType{}.WithAttributeTypes(prometheusRuleGroupTypes)
+
+	groupValues := make([]attr.Value, 0, len(groups))
+	for _, group := range groups {
+		ruleValues := make([]attr.Value, 0, len(group.Rules))
+		for _, rule := range group.Rules {
+			labels, diags := prometheusRuleMapValue(ctx, rule.Labels)
+			if diags.HasError() {
+				response.Error = function.FuncErrorFromDiags(ctx, diags)
+				return
+			}
+			annotations, diags := prometheusRuleMapValue(ctx, rule.Annotations)
+			if diags.HasError() {
+				response.Error = function.FuncErrorFromDiags(ctx, diags)
+				return
+			}
+
+			// unset values are null rather than empty so that the rules can be used in a `dynamic` block
+			ruleValue, diags := types.ObjectValue(prometheusRuleTypes, map[string]attr.Value{
+				"alert":       prometheusRuleStringValue(rule.Alert),
+				"record":      prometheusRuleStringValue(rule.Record),
+				"expression":  types.StringValue(rule.Expression),
+				"for":         prometheusRuleStringValue(rule.For),
+				"labels":      labels,
+				"annotations": annotations,
+			})
+			if diags.HasError() {
+				response.Error = function.FuncErrorFromDiags(ctx, diags)
+				return
+			}
+			ruleValues = append(ruleValues, ruleValue)
+		}
+
+		rules, diags := types.ListValue(ruleType, ruleValues)
+		if diags.HasError() {
+			response.Error = function.FuncErrorFromDiags(ctx, diags)
+			return
+		}
+
+		groupValue, diags := types.ObjectValue(prometheusRuleGroupTypes, map[string]attr.Value{
+			"name":     types.StringValue(group.Name),
+			"interval": prometheusRuleStringValue(group.Interval),
+			"rule":     rules,
+		})
+		if diags.HasError() {
+			response.Error = function.FuncErrorFromDiags(ctx, diags)
+			return
+		}
+		groupValues = append(groupValues, groupValue)
+	}
+
+	result, diags := types.ListValue(groupType, groupValues)
+	if diags.HasError() {
+		response.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
+
+func prometheusRuleStringValue(input string) types.String {
+	if input == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(input)
+}
+
+func prometheusRuleMapValue(ctx context.Context, input map[string]string) (types.Map, diag.Diagnostics) {
+	if input == nil {
+		return types.MapNull(types.StringType), nil
+	}
+	return types.MapValueFrom(ctx, types.StringType, input)
+}
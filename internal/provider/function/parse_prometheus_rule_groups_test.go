@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionParsePrometheusRuleGroups_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testParsePrometheusRuleGroupsBasic(),
+				Check: acceptance.ComposeTestCheckFunc(
+					resource.TestCheckOutput("name", "node"),
+					resource.TestCheckOutput("interval", "PT1M"),
+					resource.TestCheckOutput("alert", "HighCPU"),
+					resource.TestCheckOutput("for", "PT5M"),
+					resource.TestCheckOutput("severity", "critical"),
+					resource.TestCheckOutput("round_trip", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionParsePrometheusRuleGroups_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testParsePrometheusRuleGroupsInvalid(),
+				ExpectError: regexp.MustCompile("line 4"),
+			},
+		},
+	})
+}
+
+func testParsePrometheusRuleGroupsBasic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  rules_yaml = <<-EOT
+groups:
+  - name: node
+    interval: 1m
+    rules:
+      - alert: HighCPU
+        expr: instance:node_cpu:rate5m > 0.9
+        for: 5m
+        labels:
+          severity: critical
+EOT
+
+  rule_groups = provider::azurerm::parse_prometheus_rule_groups(local.rules_yaml)
+}
+
+output "name" {
+  value = local.rule_groups[0].name
+}
+
+output "interval" {
+  value = local.rule_groups[0].interval
+}
+
+output "alert" {
+  value = local.rule_groups[0].rule[0].alert
+}
+
+output "for" {
+  value = local.rule_groups[0].rule[0].for
+}
+
+output "severity" {
+  value = local.rule_groups[0].rule[0].labels["severity"]
+}
+
+output "round_trip" {
+  value = provider::azurerm::parse_prometheus_rule_groups(provider::azurerm::encode_prometheus_rule_groups(local.rule_groups)) == local.rule_groups
+}
+`
+}
+
+func testParsePrometheusRuleGroupsInvalid() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::parse_prometheus_rule_groups(<<-EOT
+groups:
+  - name: node
+    rules:
+      - alert: HighCPU
+EOT
+  )
+}
+`
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ParseRoleAssignmentConditionFunction struct{}
+
+var _ function.Function = ParseRoleAssignmentConditionFunction{}
+
+func NewParseRoleAssignmentConditionFunction() function.Function {
+	return &ParseRoleAssignmentConditionFunction{}
+}
+
+func (p ParseRoleAssignmentConditionFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "parse_role_assignment_condition"
+}
+
+func (p ParseRoleAssignmentConditionFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "parse_role_assignment_condition",
+		Description:         "Parses a Role Assignment condition into a list of objects, each describing the actions, attribute, operator and values of a condition",
+		MarkdownDescription: "Parses a Role Assignment condition into a list of objects, each describing the `actions`, `attribute`, `operator` and `values` of a condition",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "condition",
+				Description:         "Role Assignment condition",
+				MarkdownDescription: "Role Assignment condition",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: roleAssignmentConditionAttrTypes,
+			},
+		},
+	}
+}
+
+func (p ParseRoleAssignmentConditionFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var condition string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &condition))
+
+	if response.Error != nil {
+		return
+	}
+
+	result, err := parseRoleAssignmentCondition(condition)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
@@ -0,0 +1,994 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// policyRuleOperators are the condition operators which can be evaluated offline
+var policyRuleOperators = []string{
+	"contains",
+	"containsKey",
+	"equals",
+	"exists",
+	"greater",
+	"greaterOrEquals",
+	"in",
+	"less",
+	"lessOrEquals",
+	"like",
+	"match",
+	"matchInsensitively",
+	"notContains",
+	"notContainsKey",
+	"notEquals",
+	"notIn",
+	"notLike",
+	"notMatch",
+	"notMatchInsensitively",
+}
+
+// policyRuleValue is a value resolved from the resource document, exists is false when the field isn't present
+type policyRuleValue struct {
+	value  interface{}
+	exists bool
+}
+
+// policyRuleCountScope is the array element currently being evaluated by the `where` condition of a `count`
+type policyRuleCountScope struct {
+	field   string
+	element interface{}
+}
+
+type policyRuleEvaluator struct {
+	document   map[string]interface{}
+	parameters map[string]interface{}
+	scopes     []policyRuleCountScope
+}
+
+// evaluatePolicyRule evaluates the `if` condition of a Policy Rule against the resource document, returning the effect
+// of the rule when it matches and an empty string when it doesn't
+func evaluatePolicyRule(policyRule string, resource string, parameters string) (string, error) {
+	var policy map[string]interface{}
+	if err := json.Unmarshal([]byte(policyRule), &policy); err != nil {
+		return "", fmt.Errorf("parsing `policy_rule`: %+v", err)
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(resource), &document); err != nil {
+		return "", fmt.Errorf("parsing `resource`: %+v", err)
+	}
+
+	// both the `policyRule` and a full Policy Definition (with or without the `properties` envelope) are accepted,
+	// the latter allows the default values of the parameters to be used
+	definitions := map[string]interface{}{}
+	if properties, ok := policy["properties"].(map[string]interface{}); ok {
+		policy = properties
+	}
+	if rule, ok := policy["policyRule"].(map[string]interface{}); ok {
+		if v, ok := policy["parameters"].(map[string]interface{}); ok {
+			definitions = v
+		}
+		policy = rule
+	}
+
+	values := map[string]interface{}{}
+	for name, raw := range definitions {
+		if definition, ok := raw.(map[string]interface{}); ok {
+			if v, ok := definition["defaultValue"]; ok {
+				values[name] = v
+			}
+		}
+	}
+
+	if parameters != "" {
+		var input map[string]interface{}
+		if err := json.Unmarshal([]byte(parameters), &input); err != nil {
+			return "", fmt.Errorf("parsing `parameters`: %+v", err)
+		}
+		for name, raw := range input {
+			// parameter values can be specified in the `{"name": {"value": ...}}` format used by Policy Assignments
+			if v, ok := raw.(map[string]interface{}); ok && len(v) == 1 {
+				if value, ok := v["value"]; ok {
+					raw = value
+				}
+			}
+			values[name] = raw
+		}
+	}
+
+	condition, ok := policy["if"]
+	if !ok {
+		return "", fmt.Errorf("the policy rule must contain an `if` condition")
+	}
+	then, ok := policy["then"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("the policy rule must contain a `then` block")
+	}
+	if _, ok := then["effect"]; !ok {
+		return "", fmt.Errorf("the `then` block of the policy rule must contain an `effect`")
+	}
+
+	evaluator := policyRuleEvaluator{
+		document:   document,
+		parameters: values,
+	}
+
+	matched, err := evaluator.evaluateCondition(condition)
+	if err != nil {
+		return "", err
+	}
+	if !matched {
+		return "", nil
+	}
+
+	effect, err := evaluator.evaluateValue(then["effect"])
+	if err != nil {
+		return "", fmt.Errorf("evaluating `effect`: %+v", err)
+	}
+	result, ok := effect.(string)
+	if !ok {
+		return "", fmt.Errorf("expected the `effect` to be a string but got %T", effect)
+	}
+
+	return result, nil
+}
+
+func (e *policyRuleEvaluator) evaluateCondition(raw interface{}) (bool, error) {
+	condition, ok := raw.(map[string]interface{})
+	if !ok {
+		return false, fmt.Errorf("expected a condition to be an object but got %T", raw)
+	}
+
+	if v, ok := condition["allOf"]; ok {
+		conditions, ok := v.([]interface{})
+		if !ok || len(condition) != 1 {
+			return false, fmt.Errorf("`allOf` must be the only key of a condition and contain a list of conditions")
+		}
+		for _, c := range conditions {
+			matched, err := e.evaluateCondition(c)
+			if err != nil || !matched {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+
+	if v, ok := condition["anyOf"]; ok {
+		conditions, ok := v.([]interface{})
+		if !ok || len(condition) != 1 {
+			return false, fmt.Errorf("`anyOf` must be the only key of a condition and contain a list of conditions")
+		}
+		for _, c := range conditions {
+			matched, err := e.evaluateCondition(c)
+			if err != nil || matched {
+				return matched, err
+			}
+		}
+		return false, nil
+	}
+
+	if v, ok := condition["not"]; ok {
+		if len(condition) != 1 {
+			return false, fmt.Errorf("`not` must be the only key of a condition")
+		}
+		matched, err := e.evaluateCondition(v)
+		return !matched, err
+	}
+
+	operator := ""
+	for _, k := range policyRuleOperators {
+		if _, ok := condition[k]; ok {
+			if operator != "" {
+				return false, fmt.Errorf("a condition can only contain a single operator but got %q and %q", operator, k)
+			}
+			operator = k
+		}
+	}
+	if operator == "" {
+		return false, fmt.Errorf("a condition must contain one of `allOf`, `anyOf`, `not` or an operator (%s), got %s", strings.Join(policyRuleOperators, ", "), policyRuleConditionKeys(condition))
+	}
+	if len(condition) != 2 {
+		return false, fmt.Errorf("a condition must contain exactly one of `field`, `value` or `count` and an operator, got %s", policyRuleConditionKeys(condition))
+	}
+
+	expected, err := e.evaluateValue(condition[operator])
+	if err != nil {
+		return false, fmt.Errorf("evaluating the value of %q: %+v", operator, err)
+	}
+
+	switch {
+	case condition["field"] != nil:
+		field, ok := condition["field"].(string)
+		if !ok {
+			return false, fmt.Errorf("expected `field` to be a string but got %T", condition["field"])
+		}
+		fieldName, err := e.evaluateValue(field)
+		if err != nil {
+			return false, fmt.Errorf("evaluating `field`: %+v", err)
+		}
+		if field, ok = fieldName.(string); !ok {
+			return false, fmt.Errorf("expected `field` to evaluate to a string but got %T", fieldName)
+		}
+
+		// a condition on an alias containing `[*]` is only true when every element of the array satisfies it
+		values, _ := e.resolveField(field)
+		for _, v := range values {
+			matched, err := applyPolicyRuleOperator(operator, v, expected)
+			if err != nil || !matched {
+				return false, err
+			}
+		}
+		return true, nil
+
+	case condition["value"] != nil:
+		value, err := e.evaluateValue(condition["value"])
+		if err != nil {
+			return false, fmt.Errorf("evaluating `value`: %+v", err)
+		}
+		return applyPolicyRuleOperator(operator, policyRuleValue{value: value, exists: value != nil}, expected)
+
+	case condition["count"] != nil:
+		count, err := e.evaluateCount(condition["count"])
+		if err != nil {
+			return false, err
+		}
+		return applyPolicyRuleOperator(operator, policyRuleValue{value: float64(count), exists: true}, expected)
+	}
+
+	return false, fmt.Errorf("a condition must contain one of `field`, `value` or `count`, got %s", policyRuleConditionKeys(condition))
+}
+
+func (e *policyRuleEvaluator) evaluateCount(raw interface{}) (int, error) {
+	count, ok := raw.(map[string]interface{})
+	if !ok {
+		return 0, fmt.Errorf("expected `count` to be an object but got %T", raw)
+	}
+	if _, ok := count["value"]; ok {
+		return 0, fmt.Errorf("value count expressions are not supported, only field count expressions can be evaluated")
+	}
+
+	field, ok := count["field"].(string)
+	if !ok {
+		return 0, fmt.Errorf("`count` must contain a `field`")
+	}
+	if !strings.HasSuffix(field, "[*]") {
+		return 0, fmt.Errorf("the `field` of a `count` must be an array alias ending with `[*]` but got %q", field)
+	}
+	for k := range count {
+		if k != "field" && k != "where" {
+			return 0, fmt.Errorf("unsupported key %q in `count`", k)
+		}
+	}
+
+	values, _ := e.resolveField(field)
+	elements := make([]interface{}, 0)
+	for _, v := range values {
+		if v.exists {
+			elements = append(elements, v.value)
+		}
+	}
+
+	where, ok := count["where"]
+	if !ok {
+		return len(elements), nil
+	}
+
+	total := 0
+	for _, element := range elements {
+		e.scopes = append(e.scopes, policyRuleCountScope{
+			field:   strings.ToLower(field),
+			element: element,
+		})
+		matched, err := e.evaluateCondition(where)
+		e.scopes = e.scopes[:len(e.scopes)-1]
+		if err != nil {
+			return 0, fmt.Errorf("evaluating `where`: %+v", err)
+		}
+		if matched {
+			total++
+		}
+	}
+
+	return total, nil
+}
+
+// resolveField returns the values of a field from the resource document, more than one value is returned
+// when the field contains `[*]`, in which case wildcard is true
+func (e *policyRuleEvaluator) resolveField(field string) (values []policyRuleValue, wildcard bool) {
+	lower := strings.ToLower(field)
+
+	// fields within the `where` of a `count` are relative to the array element being counted
+	for i := len(e.scopes) - 1; i >= 0; i-- {
+		scope := e.scopes[i]
+		if lower == scope.field {
+			return []policyRuleValue{{value: scope.element, exists: true}}, false
+		}
+		if strings.HasPrefix(lower, scope.field+".") || strings.HasPrefix(lower, scope.field+"[") {
+			return resolvePolicyRuleFieldPath(scope.element, strings.TrimPrefix(field[len(scope.field):], "."))
+		}
+	}
+
+	if lower == "fullname" {
+		field = "name"
+	}
+
+	if !strings.Contains(field, "/") {
+		return resolvePolicyRuleFieldPath(e.document, field)
+	}
+
+	// aliases are resolved by removing the resource type, so that `Microsoft.Storage/storageAccounts/minimumTlsVersion`
+	// maps to `properties.minimumTlsVersion` (falling back to the top level, e.g. for `sku.name`)
+	resourceType, _ := e.document["type"].(string)
+	if resourceType == "" || !strings.HasPrefix(lower, strings.ToLower(resourceType)+"/") {
+		return []policyRuleValue{{}}, false
+	}
+	path := field[len(resourceType)+1:]
+	if strings.Contains(strings.SplitN(path, "[", 2)[0], "/") {
+		// this alias belongs to a child resource type
+		return []policyRuleValue{{}}, false
+	}
+
+	if properties, ok := e.document["properties"]; ok {
+		values, wildcard = resolvePolicyRuleFieldPath(properties, path)
+		for _, v := range values {
+			if v.exists {
+				return values, wildcard
+			}
+		}
+	}
+
+	return resolvePolicyRuleFieldPath(e.document, path)
+}
+
+type policyRuleFieldSegment struct {
+	key      string
+	wildcard bool
+}
+
+func parsePolicyRuleFieldPath(path string) []policyRuleFieldSegment {
+	segments := make([]policyRuleFieldSegment, 0)
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '.':
+			i++
+
+		case '[':
+			end := strings.Index(path[i:], "]")
+			if strings.HasPrefix(path[i:], "['") {
+				if quoted := strings.Index(path[i:], "']"); quoted != -1 {
+					segments = append(segments, policyRuleFieldSegment{key: path[i+2 : i+quoted]})
+					i += quoted + 2
+					continue
+				}
+			}
+			if end == -1 {
+				segments = append(segments, policyRuleFieldSegment{key: path[i+1:]})
+				return segments
+			}
+			if key := path[i+1 : i+end]; key == "*" {
+				segments = append(segments, policyRuleFieldSegment{wildcard: true})
+			} else {
+				segments = append(segments, policyRuleFieldSegment{key: key})
+			}
+			i += end + 1
+
+		default:
+			end := strings.IndexAny(path[i:], ".[")
+			if end == -1 {
+				end = len(path) - i
+			}
+			segments = append(segments, policyRuleFieldSegment{key: path[i : i+end]})
+			i += end
+		}
+	}
+
+	return segments
+}
+
+func resolvePolicyRuleFieldPath(root interface{}, path string) (values []policyRuleValue, wildcard bool) {
+	values = []policyRuleValue{{value: root, exists: true}}
+
+	for _, segment := range parsePolicyRuleFieldPath(path) {
+		next := make([]policyRuleValue, 0)
+		for _, current := range values {
+			if !current.exists {
+				next = append(next, current)
+				continue
+			}
+
+			if segment.wildcard {
+				wildcard = true
+				elements, ok := current.value.([]interface{})
+				if !ok {
+					next = append(next, policyRuleValue{})
+					continue
+				}
+				for _, element := range elements {
+					next = append(next, policyRuleValue{value: element, exists: true})
+				}
+				continue
+			}
+
+			object, ok := current.value.(map[string]interface{})
+			if !ok {
+				next = append(next, policyRuleValue{})
+				continue
+			}
+			value, exists := policyRuleLookup(object, segment.key)
+			next = append(next, policyRuleValue{value: value, exists: exists})
+		}
+		values = next
+	}
+
+	return values, wildcard
+}
+
+// policyRuleLookup looks up the key in the object, property names are case-insensitive in Azure Policy
+func policyRuleLookup(object map[string]interface{}, key string) (interface{}, bool) {
+	if v, ok := object[key]; ok {
+		return v, true
+	}
+	for k, v := range object {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func policyRuleConditionKeys(condition map[string]interface{}) string {
+	keys := make([]string, 0, len(condition))
+	for k := range condition {
+		keys = append(keys, fmt.Sprintf("%q", k))
+	}
+	sort.Strings(keys)
+	return "[" + strings.Join(keys, ", ") + "]"
+}
+
+func applyPolicyRuleOperator(operator string, actual policyRuleValue, expected interface{}) (bool, error) {
+	switch operator {
+	case "exists":
+		want, ok := expected.(bool)
+		if !ok {
+			s, isString := expected.(string)
+			b, err := strconv.ParseBool(s)
+			if !isString || err != nil {
+				return false, fmt.Errorf("the value of `exists` must be a boolean but got %v", expected)
+			}
+			want = b
+		}
+		return (actual.exists && actual.value != nil) == want, nil
+
+	case "equals":
+		return actual.exists && policyRuleEquals(actual.value, expected), nil
+	case "notEquals":
+		return !(actual.exists && policyRuleEquals(actual.value, expected)), nil
+
+	case "in", "notIn":
+		list, ok := expected.([]interface{})
+		if !ok {
+			return false, fmt.Errorf("the value of %q must be a list but got %T", operator, expected)
+		}
+		found := false
+		if actual.exists {
+			for _, v := range list {
+				if policyRuleEquals(actual.value, v) {
+					found = true
+					break
+				}
+			}
+		}
+		return found == (operator == "in"), nil
+
+	case "contains", "notContains":
+		found := false
+		if actual.exists {
+			switch v := actual.value.(type) {
+			case string:
+				found = strings.Contains(strings.ToLower(v), strings.ToLower(policyRuleString(expected)))
+			case []interface{}:
+				for _, element := range v {
+					if policyRuleEquals(element, expected) {
+						found = true
+						break
+					}
+				}
+			}
+		}
+		return found == (operator == "contains"), nil
+
+	case "containsKey", "notContainsKey":
+		found := false
+		if object, ok := actual.value.(map[string]interface{}); ok && actual.exists {
+			_, found = policyRuleLookup(object, policyRuleString(expected))
+		}
+		return found == (operator == "containsKey"), nil
+
+	case "like", "notLike":
+		pattern, ok := expected.(string)
+		if !ok {
+			return false, fmt.Errorf("the value of %q must be a string but got %T", operator, expected)
+		}
+		if strings.Count(pattern, "*") > 1 {
+			return false, fmt.Errorf("the value of %q can only contain a single wildcard (`*`) but got %q", operator, pattern)
+		}
+		parts := strings.Split(pattern, "*")
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+		expression := regexp.MustCompile("(?is)^" + strings.Join(parts, ".*") + "$")
+		matched := actual.exists && actual.value != nil && expression.MatchString(policyRuleString(actual.value))
+		return matched == (operator == "like"), nil
+
+	case "match", "notMatch", "matchInsensitively", "notMatchInsensitively":
+		pattern, ok := expected.(string)
+		if !ok {
+			return false, fmt.Errorf("the value of %q must be a string but got %T", operator, expected)
+		}
+		var expression strings.Builder
+		if strings.HasSuffix(operator, "Insensitively") {
+			expression.WriteString("(?i)")
+		}
+		expression.WriteString("^")
+		for _, r := range pattern {
+			switch r {
+			case '#':
+				expression.WriteString("[0-9]")
+			case '?':
+				expression.WriteString("[a-zA-Z]")
+			case '.':
+				expression.WriteString(".")
+			default:
+				expression.WriteString(regexp.QuoteMeta(string(r)))
+			}
+		}
+		expression.WriteString("$")
+		matched := actual.exists && actual.value != nil && regexp.MustCompile(expression.String()).MatchString(policyRuleString(actual.value))
+		return matched == strings.HasPrefix(operator, "match"), nil
+
+	case "less", "lessOrEquals", "greater", "greaterOrEquals":
+		if !actual.exists || actual.value == nil {
+			return false, nil
+		}
+		result, err := policyRuleCompare(actual.value, expected)
+		if err != nil {
+			return false, fmt.Errorf("evaluating %q: %+v", operator, err)
+		}
+		switch operator {
+		case "less":
+			return result < 0, nil
+		case "lessOrEquals":
+			return result <= 0, nil
+		case "greater":
+			return result > 0, nil
+		default:
+			return result >= 0, nil
+		}
+	}
+
+	return false, fmt.Errorf("operator %q is not supported", operator)
+}
+
+// policyRuleEquals compares two values, strings are compared case-insensitively and scalar values of different
+// types are compared by their string representation since Azure Policy coerces them (e.g. `true` and `"true"`)
+func policyRuleEquals(a, b interface{}) bool {
+	switch a.(type) {
+	case map[string]interface{}, []interface{}:
+		return reflect.DeepEqual(a, b)
+	}
+	switch b.(type) {
+	case map[string]interface{}, []interface{}:
+		return false
+	}
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return strings.EqualFold(policyRuleString(a), policyRuleString(b))
+}
+
+func policyRuleCompare(a, b interface{}) (int, error) {
+	if x, ok := policyRuleNumber(a); ok {
+		if y, ok := policyRuleNumber(b); ok {
+			switch {
+			case x < y:
+				return -1, nil
+			case x > y:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	}
+
+	x, okA := a.(string)
+	y, okB := b.(string)
+	if !okA || !okB {
+		return 0, fmt.Errorf("cannot compare %v (%T) with %v (%T)", a, a, b, b)
+	}
+
+	if timeA, err := time.Parse(time.RFC3339, x); err == nil {
+		if timeB, err := time.Parse(time.RFC3339, y); err == nil {
+			return timeA.Compare(timeB), nil
+		}
+	}
+
+	return strings.Compare(strings.ToLower(x), strings.ToLower(y)), nil
+}
+
+func policyRuleNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func policyRuleString(v interface{}) string {
+	switch s := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return s
+	case bool:
+		return strconv.FormatBool(s)
+	case float64:
+		return strconv.FormatFloat(s, 'f', -1, 64)
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// evaluateValue evaluates a value from the policy rule, strings of the form `[...]` are template expressions
+// whilst those starting with `[[` are escaped literals
+func (e *policyRuleEvaluator) evaluateValue(raw interface{}) (interface{}, error) {
+	s, ok := raw.(string)
+	if !ok {
+		return raw, nil
+	}
+	if strings.HasPrefix(s, "[[") {
+		return s[1:], nil
+	}
+	if !strings.HasPrefix(s, "[") || !strings.HasSuffix(s, "]") {
+		return s, nil
+	}
+
+	tokens, err := tokenizePolicyRuleExpression(s[1 : len(s)-1])
+	if err != nil {
+		return nil, fmt.Errorf("parsing expression %q: %+v", s, err)
+	}
+	parser := policyRuleExpressionParser{
+		evaluator: e,
+		tokens:    tokens,
+	}
+	result, err := parser.parseExpression()
+	if err != nil {
+		return nil, fmt.Errorf("evaluating expression %q: %+v", s, err)
+	}
+	if parser.position != len(tokens) {
+		return nil, fmt.Errorf("evaluating expression %q: unexpected %q", s, tokens[parser.position].value)
+	}
+	return result, nil
+}
+
+type policyRuleExpressionToken struct {
+	kind  string
+	value string
+}
+
+func tokenizePolicyRuleExpression(input string) ([]policyRuleExpressionToken, error) {
+	tokens := make([]policyRuleExpressionToken, 0)
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case strings.ContainsRune("(),.[]", r):
+			tokens = append(tokens, policyRuleExpressionToken{kind: "symbol", value: string(r)})
+			i++
+
+		case r == '\'':
+			var value strings.Builder
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, fmt.Errorf("unterminated string")
+				}
+				if runes[i] == '\'' {
+					// quotes are escaped by doubling them
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						value.WriteRune('\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+				i++
+			}
+			tokens = append(tokens, policyRuleExpressionToken{kind: "string", value: value.String()})
+
+		case unicode.IsDigit(r) || r == '-':
+			start := i
+			i++
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, policyRuleExpressionToken{kind: "number", value: string(runes[start:i])})
+
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, policyRuleExpressionToken{kind: "identifier", value: string(runes[start:i])})
+
+		default:
+			return nil, fmt.Errorf("unexpected character %q", r)
+		}
+	}
+
+	return tokens, nil
+}
+
+type policyRuleExpressionParser struct {
+	evaluator *policyRuleEvaluator
+	tokens    []policyRuleExpressionToken
+	position  int
+}
+
+func (p *policyRuleExpressionParser) peek() *policyRuleExpressionToken {
+	if p.position >= len(p.tokens) {
+		return nil
+	}
+	return &p.tokens[p.position]
+}
+
+func (p *policyRuleExpressionParser) expect(symbol string) error {
+	token := p.peek()
+	if token == nil || token.kind != "symbol" || token.value != symbol {
+		if token == nil {
+			return fmt.Errorf("expected %q but reached the end of the expression", symbol)
+		}
+		return fmt.Errorf("expected %q but got %q", symbol, token.value)
+	}
+	p.position++
+	return nil
+}
+
+func (p *policyRuleExpressionParser) parseExpression() (interface{}, error) {
+	token := p.peek()
+	if token == nil {
+		return nil, fmt.Errorf("unexpected end of the expression")
+	}
+	p.position++
+
+	var result interface{}
+	switch token.kind {
+	case "string":
+		result = token.value
+
+	case "number":
+		f, err := strconv.ParseFloat(token.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing number %q: %+v", token.value, err)
+		}
+		result = f
+
+	case "identifier":
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		args := make([]interface{}, 0)
+		if next := p.peek(); next == nil || next.value != ")" {
+			for {
+				arg, err := p.parseExpression()
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, arg)
+				if next := p.peek(); next != nil && next.value == "," {
+					p.position++
+					continue
+				}
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		v, err := p.evaluator.callFunction(token.value, args)
+		if err != nil {
+			return nil, err
+		}
+		result = v
+
+	default:
+		return nil, fmt.Errorf("unexpected %q", token.value)
+	}
+
+	// property and index access, e.g. `parameters('tags').environment` or `parameters('locations')[0]`
+	for {
+		next := p.peek()
+		if next == nil || next.kind != "symbol" || (next.value != "." && next.value != "[") {
+			return result, nil
+		}
+		p.position++
+
+		var key interface{}
+		if next.value == "." {
+			property := p.peek()
+			if property == nil || property.kind != "identifier" {
+				return nil, fmt.Errorf("expected a property name after `.`")
+			}
+			p.position++
+			key = property.value
+		} else {
+			v, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			key = v
+		}
+
+		switch v := result.(type) {
+		case map[string]interface{}:
+			value, ok := policyRuleLookup(v, policyRuleString(key))
+			if !ok {
+				return nil, fmt.Errorf("property %q was not found", policyRuleString(key))
+			}
+			result = value
+		case []interface{}:
+			index, ok := key.(float64)
+			if !ok || index < 0 || int(index) >= len(v) {
+				return nil, fmt.Errorf("index %v is out of range", key)
+			}
+			result = v[int(index)]
+		default:
+			return nil, fmt.Errorf("cannot access %v on a value of type %T", key, result)
+		}
+	}
+}
+
+func (e *policyRuleEvaluator) callFunction(name string, args []interface{}) (interface{}, error) {
+	expectArgs := func(count int) error {
+		if len(args) != count {
+			return fmt.Errorf("%s() expects %d argument(s) but got %d", name, count, len(args))
+		}
+		return nil
+	}
+
+	switch strings.ToLower(name) {
+	case "parameters":
+		if err := expectArgs(1); err != nil {
+			return nil, err
+		}
+		parameter := policyRuleString(args[0])
+		v, ok := e.parameters[parameter]
+		if !ok {
+			return nil, fmt.Errorf("parameter %q has no value and no default value", parameter)
+		}
+		return v, nil
+
+	case "field":
+		if err := expectArgs(1); err != nil {
+			return nil, err
+		}
+		values, wildcard := e.resolveField(policyRuleString(args[0]))
+		if !wildcard {
+			return values[0].value, nil
+		}
+		result := make([]interface{}, 0)
+		for _, v := range values {
+			if v.exists {
+				result = append(result, v.value)
+			}
+		}
+		return result, nil
+
+	case "concat":
+		var result strings.Builder
+		for _, arg := range args {
+			result.WriteString(policyRuleString(arg))
+		}
+		return result.String(), nil
+
+	case "tolower":
+		if err := expectArgs(1); err != nil {
+			return nil, err
+		}
+		return strings.ToLower(policyRuleString(args[0])), nil
+
+	case "toupper":
+		if err := expectArgs(1); err != nil {
+			return nil, err
+		}
+		return strings.ToUpper(policyRuleString(args[0])), nil
+
+	case "length":
+		if err := expectArgs(1); err != nil {
+			return nil, err
+		}
+		switch v := args[0].(type) {
+		case string:
+			return float64(len(v)), nil
+		case []interface{}:
+			return float64(len(v)), nil
+		case map[string]interface{}:
+			return float64(len(v)), nil
+		}
+		return nil, fmt.Errorf("length() expects a string, array or object but got %T", args[0])
+
+	case "empty":
+		if err := expectArgs(1); err != nil {
+			return nil, err
+		}
+		switch v := args[0].(type) {
+		case nil:
+			return true, nil
+		case string:
+			return v == "", nil
+		case []interface{}:
+			return len(v) == 0, nil
+		case map[string]interface{}:
+			return len(v) == 0, nil
+		}
+		return false, nil
+
+	case "equals":
+		if err := expectArgs(2); err != nil {
+			return nil, err
+		}
+		return reflect.DeepEqual(args[0], args[1]), nil
+
+	case "not":
+		if err := expectArgs(1); err != nil {
+			return nil, err
+		}
+		v, ok := args[0].(bool)
+		if !ok {
+			return nil, fmt.Errorf("not() expects a boolean but got %T", args[0])
+		}
+		return !v, nil
+
+	case "if":
+		if err := expectArgs(3); err != nil {
+			return nil, err
+		}
+		v, ok := args[0].(bool)
+		if !ok {
+			return nil, fmt.Errorf("if() expects a boolean condition but got %T", args[0])
+		}
+		if v {
+			return args[1], nil
+		}
+		return args[2], nil
+
+	case "true":
+		return true, expectArgs(0)
+
+	case "false":
+		return false, expectArgs(0)
+	}
+
+	return nil, fmt.Errorf("the template function %q is not supported offline", name)
+}
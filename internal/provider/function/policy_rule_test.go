@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import "testing"
+
+const testPolicyRuleStorageAccount = `{
+  "type": "Microsoft.Storage/storageAccounts",
+  "name": "examplestorage",
+  "location": "westeurope",
+  "kind": "StorageV2",
+  "sku": {
+    "name": "Standard_LRS"
+  },
+  "tags": {
+    "environment": "Production",
+    "cost-center": "1234"
+  },
+  "properties": {
+    "minimumTlsVersion": "TLS1_0",
+    "supportsHttpsTrafficOnly": false,
+    "networkAcls": {
+      "defaultAction": "Allow",
+      "ipRules": [
+        {
+          "value": "10.0.0.1",
+          "action": "Allow"
+        },
+        {
+          "value": "0.0.0.0/0",
+          "action": "Allow"
+        }
+      ]
+    }
+  }
+}`
+
+func TestEvaluatePolicyRule(t *testing.T) {
+	testData := []struct {
+		Name       string
+		PolicyRule string
+		Resource   string
+		Parameters string
+		Expected   string
+		Error      bool
+	}{
+		{
+			Name:       "invalid json",
+			PolicyRule: `{`,
+			Resource:   testPolicyRuleStorageAccount,
+			Error:      true,
+		},
+		{
+			Name:       "missing effect",
+			PolicyRule: `{"if": {"field": "type", "equals": "Microsoft.Storage/storageAccounts"}, "then": {}}`,
+			Resource:   testPolicyRuleStorageAccount,
+			Error:      true,
+		},
+		{
+			Name: "alias with coerced boolean",
+			PolicyRule: `{
+  "if": {
+    "allOf": [
+      { "field": "type", "equals": "microsoft.storage/storageaccounts" },
+      { "field": "Microsoft.Storage/storageAccounts/supportsHttpsTrafficOnly", "equals": "false" }
+    ]
+  },
+  "then": { "effect": "Deny" }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Expected: "Deny",
+		},
+		{
+			Name: "no match",
+			PolicyRule: `{
+  "if": {
+    "allOf": [
+      { "field": "type", "equals": "Microsoft.Storage/storageAccounts" },
+      { "field": "location", "notIn": ["westeurope", "northeurope"] }
+    ]
+  },
+  "then": { "effect": "Deny" }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Expected: "",
+		},
+		{
+			Name: "policy definition with default parameters",
+			PolicyRule: `{
+  "properties": {
+    "parameters": {
+      "effect": { "type": "String", "defaultValue": "Audit" },
+      "minimumTlsVersion": { "type": "String", "defaultValue": "TLS1_2" }
+    },
+    "policyRule": {
+      "if": {
+        "allOf": [
+          { "field": "type", "equals": "Microsoft.Storage/storageAccounts" },
+          {
+            "anyOf": [
+              { "field": "Microsoft.Storage/storageAccounts/minimumTlsVersion", "exists": false },
+              { "field": "Microsoft.Storage/storageAccounts/minimumTlsVersion", "notEquals": "[parameters('minimumTlsVersion')]" }
+            ]
+          }
+        ]
+      },
+      "then": { "effect": "[parameters('effect')]" }
+    }
+  }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Expected: "Audit",
+		},
+		{
+			Name: "parameters override the defaults",
+			PolicyRule: `{
+  "parameters": {
+    "effect": { "type": "String", "defaultValue": "Audit" },
+    "minimumTlsVersion": { "type": "String", "defaultValue": "TLS1_2" }
+  },
+  "policyRule": {
+    "if": { "field": "Microsoft.Storage/storageAccounts/minimumTlsVersion", "notEquals": "[parameters('minimumTlsVersion')]" },
+    "then": { "effect": "[parameters('effect')]" }
+  }
+}`,
+			Resource:   testPolicyRuleStorageAccount,
+			Parameters: `{"effect": {"value": "Deny"}, "minimumTlsVersion": "TLS1_0"}`,
+			Expected:   "",
+		},
+		{
+			Name: "missing parameter",
+			PolicyRule: `{
+  "if": { "field": "location", "in": "[parameters('allowedLocations')]" },
+  "then": { "effect": "Deny" }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Error:    true,
+		},
+		{
+			Name: "tags and not",
+			PolicyRule: `{
+  "if": {
+    "anyOf": [
+      { "not": { "field": "tags['cost-center']", "match": "####" } },
+      { "field": "tags.environment", "notIn": ["Production", "Staging"] },
+      { "field": "tags", "notContainsKey": "environment" }
+    ]
+  },
+  "then": { "effect": "Deny" }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Expected: "",
+		},
+		{
+			Name: "like and sku",
+			PolicyRule: `{
+  "if": {
+    "allOf": [
+      { "field": "name", "like": "example*" },
+      { "field": "Microsoft.Storage/storageAccounts/sku.name", "in": ["Standard_LRS", "Standard_GRS"] },
+      { "field": "kind", "matchInsensitively": "storagev#" }
+    ]
+  },
+  "then": { "effect": "Audit" }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Expected: "Audit",
+		},
+		{
+			Name: "wildcard requires every element to match",
+			PolicyRule: `{
+  "if": { "field": "Microsoft.Storage/storageAccounts/networkAcls.ipRules[*].action", "equals": "Allow" },
+  "then": { "effect": "Audit" }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Expected: "Audit",
+		},
+		{
+			Name: "wildcard with a non matching element",
+			PolicyRule: `{
+  "if": { "field": "Microsoft.Storage/storageAccounts/networkAcls.ipRules[*].value", "notEquals": "0.0.0.0/0" },
+  "then": { "effect": "Audit" }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Expected: "",
+		},
+		{
+			Name: "count with where",
+			PolicyRule: `{
+  "if": {
+    "count": {
+      "field": "Microsoft.Storage/storageAccounts/networkAcls.ipRules[*]",
+      "where": { "field": "Microsoft.Storage/storageAccounts/networkAcls.ipRules[*].value", "equals": "0.0.0.0/0" }
+    },
+    "greater": 0
+  },
+  "then": { "effect": "Deny" }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Expected: "Deny",
+		},
+		{
+			Name: "count without where",
+			PolicyRule: `{
+  "if": { "count": { "field": "Microsoft.Storage/storageAccounts/networkAcls.ipRules[*]" }, "lessOrEquals": 1 },
+  "then": { "effect": "Audit" }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Expected: "",
+		},
+		{
+			Name: "value condition with template functions",
+			PolicyRule: `{
+  "if": {
+    "allOf": [
+      { "value": "[concat(toLower(field('name')), '-', field('location'))]", "equals": "examplestorage-westeurope" },
+      { "value": "[length(field('Microsoft.Storage/storageAccounts/networkAcls.ipRules[*]'))]", "greaterOrEquals": 2 },
+      { "value": "[empty(field('Microsoft.Storage/storageAccounts/allowBlobPublicAccess'))]", "equals": true }
+    ]
+  },
+  "then": { "effect": "Audit" }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Expected: "Audit",
+		},
+		{
+			Name: "alias for a different resource type",
+			PolicyRule: `{
+  "if": { "field": "Microsoft.Compute/virtualMachines/storageProfile.osDisk.osType", "exists": true },
+  "then": { "effect": "Audit" }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Expected: "",
+		},
+		{
+			Name: "unsupported template function",
+			PolicyRule: `{
+  "if": { "field": "location", "equals": "[resourceGroup().location]" },
+  "then": { "effect": "Audit" }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Error:    true,
+		},
+		{
+			Name: "multiple operators",
+			PolicyRule: `{
+  "if": { "field": "location", "equals": "westeurope", "notEquals": "northeurope" },
+  "then": { "effect": "Audit" }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Error:    true,
+		},
+		{
+			Name: "date comparison",
+			PolicyRule: `{
+  "if": { "value": "2024-06-01T00:00:00Z", "less": "2025-01-01T00:00:00Z" },
+  "then": { "effect": "Audit" }
+}`,
+			Resource: testPolicyRuleStorageAccount,
+			Expected: "Audit",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := evaluatePolicyRule(v.PolicyRule, v.Resource, v.Parameters)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but got %q", actual)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,396 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// prometheusRuleGroup is a Prometheus rule group using the field names and formats of the
+// `azurerm_monitor_alert_prometheus_rule_group` resource, durations are therefore ISO 8601 durations
+type prometheusRuleGroup struct {
+	Name     string
+	Interval string
+	Rules    []prometheusRule
+}
+
+type prometheusRule struct {
+	Alert       string
+	Record      string
+	Expression  string
+	For         string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// prometheusRuleGroupsFile is used to write a Prometheus rule file in the order the fields are conventionally written
+type prometheusRuleGroupsFile struct {
+	Groups []prometheusRuleGroupYAML `yaml:"groups"`
+}
+
+type prometheusRuleGroupYAML struct {
+	Name     string               `yaml:"name"`
+	Interval string               `yaml:"interval,omitempty"`
+	Rules    []prometheusRuleYAML `yaml:"rules"`
+}
+
+type prometheusRuleYAML struct {
+	Record      string            `yaml:"record,omitempty"`
+	Alert       string            `yaml:"alert,omitempty"`
+	Expr        string            `yaml:"expr"`
+	For         string            `yaml:"for,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+var (
+	prometheusDurationRegex = regexp.MustCompile(`^(?:(\d+)y)?(?:(\d+)w)?(?:(\d+)d)?(?:(\d+)h)?(?:(\d+)m)?(?:(\d+)s)?(?:(\d+)ms)?$`)
+	iso8601DurationRegex    = regexp.MustCompile(`^P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+)
+
+// parsePrometheusRuleGroups parses the rule groups in a Prometheus rule file, errors reference the line in the file
+// containing the invalid value
+func parsePrometheusRuleGroups(input string) ([]prometheusRuleGroup, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(input), &document); err != nil {
+		return nil, fmt.Errorf("parsing the rule file: %+v", err)
+	}
+
+	if document.Kind != yaml.DocumentNode || len(document.Content) == 0 {
+		return nil, fmt.Errorf("the rule file is empty")
+	}
+
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, prometheusRuleError(root, "expected a mapping containing `groups`")
+	}
+
+	var groupsNode *yaml.Node
+	for i := 0; i < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		if key.Value != "groups" {
+			return nil, prometheusRuleError(key, "unexpected field %q, only `groups` is supported", key.Value)
+		}
+		groupsNode = value
+	}
+
+	if groupsNode == nil {
+		return nil, prometheusRuleError(root, "`groups` must be specified")
+	}
+	if groupsNode.Kind != yaml.SequenceNode {
+		return nil, prometheusRuleError(groupsNode, "`groups` must be a list")
+	}
+
+	groups := make([]prometheusRuleGroup, 0, len(groupsNode.Content))
+	names := make(map[string]int)
+	for _, groupNode := range groupsNode.Content {
+		group, err := parsePrometheusRuleGroup(groupNode)
+		if err != nil {
+			return nil, err
+		}
+
+		if line, exists := names[group.Name]; exists {
+			return nil, prometheusRuleError(groupNode, "the group %q is already defined on line %d", group.Name, line)
+		}
+		names[group.Name] = groupNode.Line
+
+		groups = append(groups, *group)
+	}
+
+	return groups, nil
+}
+
+func parsePrometheusRuleGroup(node *yaml.Node) (*prometheusRuleGroup, error) {
+	if node.Kind != yaml.MappingNode {
+		return nil, prometheusRuleError(node, "expected a rule group")
+	}
+
+	group := prometheusRuleGroup{
+		Rules: make([]prometheusRule, 0),
+	}
+
+	var rulesNode *yaml.Node
+	for i := 0; i < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		switch key.Value {
+		case "name":
+			name, err := prometheusRuleString(key, value)
+			if err != nil {
+				return nil, err
+			}
+			group.Name = name
+
+		case "interval":
+			interval, err := prometheusRuleDuration(key, value)
+			if err != nil {
+				return nil, err
+			}
+			group.Interval = interval
+
+		case "rules":
+			if value.Kind != yaml.SequenceNode {
+				return nil, prometheusRuleError(value, "`rules` must be a list")
+			}
+			rulesNode = value
+
+		case "limit", "query_offset", "labels":
+			return nil, prometheusRuleError(key, "the group field %q is not supported by Azure Monitor", key.Value)
+
+		default:
+			return nil, prometheusRuleError(key, "unexpected group field %q", key.Value)
+		}
+	}
+
+	if group.Name == "" {
+		return nil, prometheusRuleError(node, "the group `name` must be specified")
+	}
+
+	if rulesNode == nil || len(rulesNode.Content) == 0 {
+		return nil, prometheusRuleError(node, "the group %q must contain at least one rule", group.Name)
+	}
+
+	for _, ruleNode := range rulesNode.Content {
+		rule, err := parsePrometheusRule(ruleNode)
+		if err != nil {
+			return nil, err
+		}
+		group.Rules = append(group.Rules, *rule)
+	}
+
+	return &group, nil
+}
+
+func parsePrometheusRule(node *yaml.Node) (*prometheusRule, error) {
+	if node.Kind != yaml.MappingNode {
+		return nil, prometheusRuleError(node, "expected a rule")
+	}
+
+	rule := prometheusRule{}
+	var err error
+	for i := 0; i < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		switch key.Value {
+		case "alert":
+			rule.Alert, err = prometheusRuleString(key, value)
+		case "record":
+			rule.Record, err = prometheusRuleString(key, value)
+		case "expr":
+			rule.Expression, err = prometheusRuleString(key, value)
+		case "for":
+			rule.For, err = prometheusRuleDuration(key, value)
+		case "labels":
+			rule.Labels, err = prometheusRuleStringMap(key, value)
+		case "annotations":
+			rule.Annotations, err = prometheusRuleStringMap(key, value)
+		case "keep_firing_for":
+			err = prometheusRuleError(key, "the rule field %q is not supported by Azure Monitor", key.Value)
+		default:
+			err = prometheusRuleError(key, "unexpected rule field %q", key.Value)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if (rule.Alert == "") == (rule.Record == "") {
+		return nil, prometheusRuleError(node, "exactly one of `alert` or `record` must be specified")
+	}
+
+	if strings.TrimSpace(rule.Expression) == "" {
+		return nil, prometheusRuleError(node, "`expr` must be specified")
+	}
+
+	if rule.Record != "" && (rule.For != "" || rule.Annotations != nil) {
+		return nil, prometheusRuleError(node, "`for` and `annotations` cannot be specified for the recording rule %q", rule.Record)
+	}
+
+	return &rule, nil
+}
+
+func prometheusRuleString(key, value *yaml.Node) (string, error) {
+	if value.Kind != yaml.ScalarNode || value.Tag == "!!null" {
+		return "", prometheusRuleError(value, "`%s` must be a string", key.Value)
+	}
+	return value.Value, nil
+}
+
+func prometheusRuleStringMap(key, value *yaml.Node) (map[string]string, error) {
+	if value.Kind != yaml.MappingNode {
+		return nil, prometheusRuleError(value, "`%s` must be a mapping", key.Value)
+	}
+
+	output := make(map[string]string)
+	for i := 0; i < len(value.Content); i += 2 {
+		k, v := value.Content[i], value.Content[i+1]
+		if v.Kind != yaml.ScalarNode {
+			return nil, prometheusRuleError(v, "the value of `%s.%s` must be a string", key.Value, k.Value)
+		}
+		output[k.Value] = v.Value
+	}
+
+	return output, nil
+}
+
+func prometheusRuleDuration(key, value *yaml.Node) (string, error) {
+	raw, err := prometheusRuleString(key, value)
+	if err != nil {
+		return "", err
+	}
+
+	duration, err := prometheusDurationToISO8601(raw)
+	if err != nil {
+		return "", prometheusRuleError(value, "`%s`: %+v", key.Value, err)
+	}
+
+	return duration, nil
+}
+
+func prometheusRuleError(node *yaml.Node, format string, a ...interface{}) error {
+	return fmt.Errorf("line %d: %s", node.Line, fmt.Sprintf(format, a...))
+}
+
+// prometheusDurationToISO8601 converts a Prometheus duration such as `1h30m` into the ISO 8601 duration `PT1H30M`
+// used by Azure Monitor, weeks and years are converted to days as Prometheus uses fixed length units
+func prometheusDurationToISO8601(input string) (string, error) {
+	matches := prometheusDurationRegex.FindStringSubmatch(input)
+	if input == "" || matches == nil {
+		return "", fmt.Errorf("%q is not a valid Prometheus duration", input)
+	}
+
+	units := make([]int64, len(matches)-1)
+	for i, v := range matches[1:] {
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("%q is not a valid Prometheus duration: %+v", input, err)
+		}
+		units[i] = n
+	}
+
+	years, weeks, days, hours, minutes, seconds, milliseconds := units[0], units[1], units[2], units[3], units[4], units[5], units[6]
+	if milliseconds != 0 {
+		return "", fmt.Errorf("%q cannot be represented since Azure Monitor does not support durations in milliseconds", input)
+	}
+
+	days += years*365 + weeks*7
+
+	output := "P"
+	if days > 0 {
+		output += fmt.Sprintf("%dD", days)
+	}
+
+	if hours > 0 || minutes > 0 || seconds > 0 || days == 0 {
+		output += "T"
+		if hours > 0 {
+			output += fmt.Sprintf("%dH", hours)
+		}
+		if minutes > 0 {
+			output += fmt.Sprintf("%dM", minutes)
+		}
+		if seconds > 0 || (days == 0 && hours == 0 && minutes == 0) {
+			output += fmt.Sprintf("%dS", seconds)
+		}
+	}
+
+	return output, nil
+}
+
+// iso8601DurationToPrometheus converts an ISO 8601 duration such as `PT1H30M` into the Prometheus duration `1h30m`
+func iso8601DurationToPrometheus(input string) (string, error) {
+	matches := iso8601DurationRegex.FindStringSubmatch(strings.ToUpper(input))
+	if matches == nil || input == "P" || strings.HasSuffix(strings.ToUpper(input), "T") {
+		return "", fmt.Errorf("%q is not a supported ISO 8601 duration", input)
+	}
+
+	output := ""
+	for i, unit := range []string{"w", "d", "h", "m", "s"} {
+		if v := matches[i+1]; v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return "", fmt.Errorf("%q is not a supported ISO 8601 duration: %+v", input, err)
+			}
+			if n > 0 {
+				output += fmt.Sprintf("%d%s", n, unit)
+			}
+		}
+	}
+
+	if output == "" {
+		output = "0s"
+	}
+
+	return output, nil
+}
+
+// encodePrometheusRuleGroups writes the rule groups as a Prometheus rule file
+func encodePrometheusRuleGroups(groups []prometheusRuleGroup) (string, error) {
+	file := prometheusRuleGroupsFile{
+		Groups: make([]prometheusRuleGroupYAML, 0, len(groups)),
+	}
+
+	for i, group := range groups {
+		if group.Name == "" {
+			return "", fmt.Errorf("the `name` of rule group %d must be specified", i)
+		}
+
+		output := prometheusRuleGroupYAML{
+			Name:  group.Name,
+			Rules: make([]prometheusRuleYAML, 0, len(group.Rules)),
+		}
+
+		if group.Interval != "" {
+			interval, err := iso8601DurationToPrometheus(group.Interval)
+			if err != nil {
+				return "", fmt.Errorf("the `interval` of rule group %q: %+v", group.Name, err)
+			}
+			output.Interval = interval
+		}
+
+		for j, rule := range group.Rules {
+			if (rule.Alert == "") == (rule.Record == "") {
+				return "", fmt.Errorf("exactly one of `alert` or `record` must be specified for rule %d of rule group %q", j, group.Name)
+			}
+
+			item := prometheusRuleYAML{
+				Alert:       rule.Alert,
+				Record:      rule.Record,
+				Expr:        rule.Expression,
+				Labels:      rule.Labels,
+				Annotations: rule.Annotations,
+			}
+
+			if rule.For != "" {
+				duration, err := iso8601DurationToPrometheus(rule.For)
+				if err != nil {
+					return "", fmt.Errorf("the `for` of rule %d of rule group %q: %+v", j, group.Name, err)
+				}
+				item.For = duration
+			}
+
+			output.Rules = append(output.Rules, item)
+		}
+
+		file.Groups = append(file.Groups, output)
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(file); err != nil {
+		return "", fmt.Errorf("encoding the rule groups: %+v", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("encoding the rule groups: %+v", err)
+	}
+
+	return buf.String(), nil
+}
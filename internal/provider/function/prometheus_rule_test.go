@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testPrometheusRuleFile = `groups:
+  - name: node
+    interval: 1m
+    rules:
+      - record: instance:node_cpu:rate5m
+        expr: sum by (instance) (rate(node_cpu_seconds_total{mode!="idle"}[5m]))
+        labels:
+          team: sre
+      - alert: HighCPU
+        expr: |-
+          instance:node_cpu:rate5m
+            > 0.9
+        for: 1h30m
+        labels:
+          severity: critical
+        annotations:
+          summary: CPU usage on {{ $labels.instance }} is high
+  - name: kubernetes
+    rules:
+      - alert: KubePodCrashLooping
+        expr: max_over_time(kube_pod_container_status_waiting_reason{reason="CrashLoopBackOff"}[5m]) >= 1
+        for: 1w
+`
+
+func TestParsePrometheusRuleGroups(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected []prometheusRuleGroup
+		Error    string
+	}{
+		{
+			Name:  "valid",
+			Input: testPrometheusRuleFile,
+			Expected: []prometheusRuleGroup{
+				{
+					Name:     "node",
+					Interval: "PT1M",
+					Rules: []prometheusRule{
+						{
+							Record:     "instance:node_cpu:rate5m",
+							Expression: `sum by (instance) (rate(node_cpu_seconds_total{mode!="idle"}[5m]))`,
+							Labels:     map[string]string{"team": "sre"},
+						},
+						{
+							Alert:       "HighCPU",
+							Expression:  "instance:node_cpu:rate5m\n  > 0.9",
+							For:         "PT1H30M",
+							Labels:      map[string]string{"severity": "critical"},
+							Annotations: map[string]string{"summary": "CPU usage on {{ $labels.instance }} is high"},
+						},
+					},
+				},
+				{
+					Name: "kubernetes",
+					Rules: []prometheusRule{
+						{
+							Alert:      "KubePodCrashLooping",
+							Expression: `max_over_time(kube_pod_container_status_waiting_reason{reason="CrashLoopBackOff"}[5m]) >= 1`,
+							For:        "P7D",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "invalid yaml",
+			Input: "groups:\n  - name: [",
+			Error: "line",
+		},
+		{
+			Name:  "empty",
+			Input: "",
+			Error: "empty",
+		},
+		{
+			Name:  "missing groups",
+			Input: "rules: []\n",
+			Error: "line 1: unexpected field \"rules\"",
+		},
+		{
+			Name:  "missing name",
+			Input: "groups:\n  - rules:\n      - alert: A\n        expr: up == 0\n",
+			Error: "line 2: the group `name` must be specified",
+		},
+		{
+			Name:  "duplicate group",
+			Input: "groups:\n  - name: a\n    rules:\n      - alert: A\n        expr: up == 0\n  - name: a\n    rules:\n      - alert: B\n        expr: up == 0\n",
+			Error: "line 6: the group \"a\" is already defined on line 2",
+		},
+		{
+			Name:  "alert and record",
+			Input: "groups:\n  - name: a\n    rules:\n      - alert: A\n        record: b\n        expr: up == 0\n",
+			Error: "line 4: exactly one of `alert` or `record`",
+		},
+		{
+			Name:  "missing expression",
+			Input: "groups:\n  - name: a\n    rules:\n      - alert: A\n",
+			Error: "line 4: `expr` must be specified",
+		},
+		{
+			Name:  "invalid duration",
+			Input: "groups:\n  - name: a\n    rules:\n      - alert: A\n        expr: up == 0\n        for: 5 minutes\n",
+			Error: "line 6: `for`",
+		},
+		{
+			Name:  "milliseconds",
+			Input: "groups:\n  - name: a\n    interval: 500ms\n    rules:\n      - alert: A\n        expr: up == 0\n",
+			Error: "line 3: `interval`",
+		},
+		{
+			Name:  "recording rule with for",
+			Input: "groups:\n  - name: a\n    rules:\n      - record: a:b\n        expr: up\n        for: 5m\n",
+			Error: "line 4: `for` and `annotations` cannot be specified",
+		},
+		{
+			Name:  "unsupported rule field",
+			Input: "groups:\n  - name: a\n    rules:\n      - alert: A\n        expr: up == 0\n        keep_firing_for: 5m\n",
+			Error: "line 6: the rule field \"keep_firing_for\" is not supported",
+		},
+		{
+			Name:  "unsupported group field",
+			Input: "groups:\n  - name: a\n    limit: 10\n    rules:\n      - alert: A\n        expr: up == 0\n",
+			Error: "line 3: the group field \"limit\" is not supported",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := parsePrometheusRuleGroups(v.Input)
+		if err != nil {
+			if v.Error != "" && strings.Contains(err.Error(), v.Error) {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error != "" {
+			t.Fatalf("expected an error containing %q but got none", v.Error)
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestEncodePrometheusRuleGroups(t *testing.T) {
+	groups, err := parsePrometheusRuleGroups(testPrometheusRuleFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	encoded, err := encodePrometheusRuleGroups(groups)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	for _, v := range []string{"interval: 1m", "for: 1h30m", "for: 7d", "expr: |-"} {
+		if !strings.Contains(encoded, v) {
+			t.Fatalf("expected the encoded rule file to contain %q:\n%s", v, encoded)
+		}
+	}
+
+	roundTrip, err := parsePrometheusRuleGroups(encoded)
+	if err != nil {
+		t.Fatalf("parsing the encoded rule file: %+v", err)
+	}
+
+	if !reflect.DeepEqual(groups, roundTrip) {
+		t.Fatalf("expected %+v but got %+v", groups, roundTrip)
+	}
+
+	if _, err := encodePrometheusRuleGroups([]prometheusRuleGroup{{Name: "a", Interval: "1 minute"}}); err == nil {
+		t.Fatalf("expected an error for an invalid interval")
+	}
+}
+
+func TestPrometheusDurationToISO8601(t *testing.T) {
+	testData := map[string]string{
+		"0s":     "PT0S",
+		"30s":    "PT30S",
+		"5m":     "PT5M",
+		"1h30m":  "PT1H30M",
+		"1d":     "P1D",
+		"1d12h":  "P1DT12H",
+		"2w":     "P14D",
+		"1y":     "P365D",
+		"1h0m5s": "PT1H5S",
+	}
+
+	for input, expected := range testData {
+		actual, err := prometheusDurationToISO8601(input)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %+v", input, err)
+		}
+		if actual != expected {
+			t.Fatalf("expected %q for %q but got %q", expected, input, actual)
+		}
+
+		reverse, err := iso8601DurationToPrometheus(actual)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %+v", actual, err)
+		}
+		roundTrip, _ := prometheusDurationToISO8601(reverse)
+		if roundTrip != expected {
+			t.Fatalf("expected %q to round trip but got %q", expected, roundTrip)
+		}
+	}
+
+	for _, input := range []string{"", "5", "5 m", "1m1h", "100ms"} {
+		if _, err := prometheusDurationToISO8601(input); err == nil {
+			t.Fatalf("expected an error for %q", input)
+		}
+	}
+}
@@ -0,0 +1,509 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// roleAssignmentConditionAttrTypes describes a single condition block, which limits the `actions` to requests where
+// the `attribute` matches the `values` using the `operator`
+var roleAssignmentConditionAttrTypes = map[string]attr.Type{
+	"actions":   types.ListType{ElemType: types.StringType},
+	"attribute": types.StringType,
+	"operator":  types.StringType,
+	"values":    types.ListType{ElemType: types.StringType},
+}
+
+type roleAssignmentCondition struct {
+	Actions   []string `tfsdk:"actions"`
+	Attribute string   `tfsdk:"attribute"`
+	Operator  string   `tfsdk:"operator"`
+	Values    []string `tfsdk:"values"`
+}
+
+type roleAssignmentConditionValueType string
+
+const (
+	roleAssignmentConditionValueTypeBoolean  roleAssignmentConditionValueType = "Bool"
+	roleAssignmentConditionValueTypeDateTime roleAssignmentConditionValueType = "DateTime"
+	roleAssignmentConditionValueTypeGuid     roleAssignmentConditionValueType = "Guid"
+	roleAssignmentConditionValueTypeNumeric  roleAssignmentConditionValueType = "Numeric"
+	roleAssignmentConditionValueTypeString   roleAssignmentConditionValueType = "String"
+)
+
+var roleAssignmentConditionOperators = map[string]roleAssignmentConditionValueType{
+	"BoolEquals":                 roleAssignmentConditionValueTypeBoolean,
+	"BoolNotEquals":              roleAssignmentConditionValueTypeBoolean,
+	"DateTimeEquals":             roleAssignmentConditionValueTypeDateTime,
+	"DateTimeNotEquals":          roleAssignmentConditionValueTypeDateTime,
+	"DateTimeGreaterThan":        roleAssignmentConditionValueTypeDateTime,
+	"DateTimeGreaterThanEquals":  roleAssignmentConditionValueTypeDateTime,
+	"DateTimeLessThan":           roleAssignmentConditionValueTypeDateTime,
+	"DateTimeLessThanEquals":     roleAssignmentConditionValueTypeDateTime,
+	"GuidEquals":                 roleAssignmentConditionValueTypeGuid,
+	"GuidNotEquals":              roleAssignmentConditionValueTypeGuid,
+	"NumericEquals":              roleAssignmentConditionValueTypeNumeric,
+	"NumericNotEquals":           roleAssignmentConditionValueTypeNumeric,
+	"NumericGreaterThan":         roleAssignmentConditionValueTypeNumeric,
+	"NumericGreaterThanEquals":   roleAssignmentConditionValueTypeNumeric,
+	"NumericLessThan":            roleAssignmentConditionValueTypeNumeric,
+	"NumericLessThanEquals":      roleAssignmentConditionValueTypeNumeric,
+	"StringEquals":               roleAssignmentConditionValueTypeString,
+	"StringNotEquals":            roleAssignmentConditionValueTypeString,
+	"StringEqualsIgnoreCase":     roleAssignmentConditionValueTypeString,
+	"StringNotEqualsIgnoreCase":  roleAssignmentConditionValueTypeString,
+	"StringLike":                 roleAssignmentConditionValueTypeString,
+	"StringNotLike":              roleAssignmentConditionValueTypeString,
+	"StringStartsWith":           roleAssignmentConditionValueTypeString,
+	"StringNotStartsWith":        roleAssignmentConditionValueTypeString,
+	"StringStartsWithIgnoreCase": roleAssignmentConditionValueTypeString,
+}
+
+// roleAssignmentConditionSetOperators compare multi-valued attributes, and are used as a prefix to the operator,
+// e.g. `ForAnyOfAnyValues:StringEquals`
+var roleAssignmentConditionSetOperators = []string{
+	"ForAllOfAllValues",
+	"ForAllOfAnyValues",
+	"ForAnyOfAllValues",
+	"ForAnyOfAnyValues",
+}
+
+var roleAssignmentConditionEnvironmentAttributes = []string{
+	"isPrivateLink",
+	"Microsoft.Network/privateEndpoints",
+	"Microsoft.Network/virtualNetworks/subnets",
+	"UtcNow",
+}
+
+var (
+	roleAssignmentConditionAttributeRegex = regexp.MustCompile(`^@(Environment|Principal|Request|Resource)\[([^\[\]]+)]$`)
+	roleAssignmentConditionNamespaceRegex = regexp.MustCompile(`^[A-Za-z0-9]+(\.[A-Za-z0-9]+)+(/[A-Za-z0-9]+)+:[^\s:]+$`)
+	roleAssignmentConditionActionRegex    = regexp.MustCompile(`^[A-Za-z0-9]+(\.[A-Za-z0-9]+)+(/[A-Za-z0-9]+)*/(read|write|delete|action)$`)
+)
+
+func validateRoleAssignmentCondition(input roleAssignmentCondition) error {
+	if len(input.Actions) == 0 {
+		return fmt.Errorf("at least one action must be specified")
+	}
+	for _, action := range input.Actions {
+		if !roleAssignmentConditionActionRegex.MatchString(action) {
+			return fmt.Errorf("action %q is invalid, expected a Data Action such as `Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read`", action)
+		}
+	}
+
+	if err := validateRoleAssignmentConditionAttribute(input.Attribute); err != nil {
+		return err
+	}
+
+	setOperator, valueType, err := parseRoleAssignmentConditionOperator(input.Operator)
+	if err != nil {
+		return err
+	}
+
+	if len(input.Values) == 0 {
+		return fmt.Errorf("at least one value must be specified for %q", input.Attribute)
+	}
+	if len(input.Values) > 1 && setOperator == "" {
+		return fmt.Errorf("operator %q only supports a single value, use a cross product operator such as `ForAnyOfAnyValues:%s` to compare multiple values", input.Operator, input.Operator)
+	}
+
+	for _, value := range input.Values {
+		if err := validateRoleAssignmentConditionValue(valueType, value); err != nil {
+			return fmt.Errorf("value %q for operator %q: %+v", value, input.Operator, err)
+		}
+	}
+
+	return nil
+}
+
+func validateRoleAssignmentConditionAttribute(input string) error {
+	matches := roleAssignmentConditionAttributeRegex.FindStringSubmatch(input)
+	if matches == nil {
+		return fmt.Errorf("attribute %q is invalid, expected the format `@Source[namespace:attribute]` where Source is one of `Environment`, `Principal`, `Request` or `Resource`", input)
+	}
+
+	source, name := matches[1], matches[2]
+	if source == "Environment" {
+		for _, v := range roleAssignmentConditionEnvironmentAttributes {
+			if v == name {
+				return nil
+			}
+		}
+		return fmt.Errorf("attribute %q is invalid, the supported Environment attributes are %s", input, strings.Join(roleAssignmentConditionEnvironmentAttributes, ", "))
+	}
+
+	if !roleAssignmentConditionNamespaceRegex.MatchString(name) {
+		return fmt.Errorf("attribute %q is invalid, expected the attribute name in the format `Microsoft.Provider/resourceType:attributeName`", input)
+	}
+
+	return nil
+}
+
+// parseRoleAssignmentConditionOperator splits an operator into the cross product operator (if any) and returns the
+// type of value which the operator compares
+func parseRoleAssignmentConditionOperator(input string) (string, roleAssignmentConditionValueType, error) {
+	setOperator := ""
+	operator := input
+	if prefix, suffix, ok := strings.Cut(input, ":"); ok {
+		for _, v := range roleAssignmentConditionSetOperators {
+			if v == prefix {
+				setOperator = v
+			}
+		}
+		if setOperator == "" {
+			return "", "", fmt.Errorf("cross product operator %q is invalid, possible values are %s", prefix, strings.Join(roleAssignmentConditionSetOperators, ", "))
+		}
+		operator = suffix
+	}
+
+	valueType, ok := roleAssignmentConditionOperators[operator]
+	if !ok {
+		return "", "", fmt.Errorf("operator %q is not supported", operator)
+	}
+
+	return setOperator, valueType, nil
+}
+
+func validateRoleAssignmentConditionValue(valueType roleAssignmentConditionValueType, input string) error {
+	switch valueType {
+	case roleAssignmentConditionValueTypeBoolean:
+		if input != "true" && input != "false" {
+			return fmt.Errorf("expected `true` or `false`")
+		}
+	case roleAssignmentConditionValueTypeDateTime:
+		if _, err := time.Parse(time.RFC3339, input); err != nil {
+			return fmt.Errorf("expected an RFC3339 date time")
+		}
+	case roleAssignmentConditionValueTypeGuid:
+		if _, err := uuid.ParseUUID(input); err != nil {
+			return fmt.Errorf("expected a GUID")
+		}
+	case roleAssignmentConditionValueTypeNumeric:
+		if _, err := strconv.ParseFloat(input, 64); err != nil {
+			return fmt.Errorf("expected a number")
+		}
+	case roleAssignmentConditionValueTypeString:
+		if strings.Contains(input, "'") {
+			return fmt.Errorf("string values cannot contain a single quote")
+		}
+	}
+
+	return nil
+}
+
+// buildRoleAssignmentCondition renders the condition blocks in the format used by the Azure Portal, each block grants
+// the actions only when the expression is true and the blocks are combined with AND
+func buildRoleAssignmentCondition(input []roleAssignmentCondition) (string, error) {
+	if len(input) == 0 {
+		return "", fmt.Errorf("at least one condition must be specified")
+	}
+
+	blocks := make([]string, 0)
+	for i, condition := range input {
+		if err := validateRoleAssignmentCondition(condition); err != nil {
+			return "", fmt.Errorf("condition %d: %+v", i, err)
+		}
+
+		actions := make([]string, 0)
+		for _, action := range condition.Actions {
+			actions = append(actions, fmt.Sprintf("  !(ActionMatches{'%s'})", action))
+		}
+
+		_, valueType, _ := parseRoleAssignmentConditionOperator(condition.Operator)
+		values := make([]string, 0)
+		for _, value := range condition.Values {
+			if valueType == roleAssignmentConditionValueTypeBoolean || valueType == roleAssignmentConditionValueTypeNumeric {
+				values = append(values, value)
+			} else {
+				values = append(values, fmt.Sprintf("'%s'", value))
+			}
+		}
+		value := values[0]
+		if strings.Contains(condition.Operator, ":") {
+			value = fmt.Sprintf("{%s}", strings.Join(values, ", "))
+		}
+
+		blocks = append(blocks, strings.Join([]string{
+			"(",
+			" (",
+			strings.Join(actions, "\n  AND\n"),
+			" )",
+			" OR",
+			" (",
+			fmt.Sprintf("  %s %s %s", condition.Attribute, condition.Operator, value),
+			" )",
+			")",
+		}, "\n"))
+	}
+
+	return strings.Join(blocks, "\nAND\n"), nil
+}
+
+type roleAssignmentConditionParser struct {
+	tokens   []string
+	position int
+}
+
+// parseRoleAssignmentCondition parses a condition made up of blocks in the format generated by the Azure Portal (and
+// buildRoleAssignmentCondition) back into the condition blocks
+func parseRoleAssignmentCondition(input string) ([]roleAssignmentCondition, error) {
+	tokens, err := tokenizeRoleAssignmentCondition(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("the condition was empty")
+	}
+
+	p := &roleAssignmentConditionParser{tokens: tokens}
+	output := make([]roleAssignmentCondition, 0)
+	for {
+		condition, err := p.parseBlock()
+		if err != nil {
+			return nil, err
+		}
+		if err := validateRoleAssignmentCondition(*condition); err != nil {
+			return nil, fmt.Errorf("condition %d: %+v", len(output), err)
+		}
+		output = append(output, *condition)
+
+		if p.done() {
+			break
+		}
+		if err := p.expectKeyword("AND"); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func (p *roleAssignmentConditionParser) done() bool {
+	return p.position >= len(p.tokens)
+}
+
+func (p *roleAssignmentConditionParser) peek() string {
+	if p.done() {
+		return ""
+	}
+	return p.tokens[p.position]
+}
+
+func (p *roleAssignmentConditionParser) next() (string, error) {
+	if p.done() {
+		return "", fmt.Errorf("unexpected end of condition")
+	}
+	token := p.tokens[p.position]
+	p.position++
+	return token, nil
+}
+
+func (p *roleAssignmentConditionParser) expect(expected string) error {
+	token, err := p.next()
+	if err != nil {
+		return fmt.Errorf("expected %q: %+v", expected, err)
+	}
+	if token != expected {
+		return fmt.Errorf("expected %q but got %q", expected, token)
+	}
+	return nil
+}
+
+// expectKeyword matches the logical operators, which can be written as words in any case or as symbols
+func (p *roleAssignmentConditionParser) expectKeyword(keyword string) error {
+	symbols := map[string]string{
+		"AND": "&&",
+		"OR":  "||",
+	}
+	token, err := p.next()
+	if err != nil {
+		return fmt.Errorf("expected %q: %+v", keyword, err)
+	}
+	if !strings.EqualFold(token, keyword) && token != symbols[keyword] {
+		return fmt.Errorf("expected %q but got %q", keyword, token)
+	}
+	return nil
+}
+
+func (p *roleAssignmentConditionParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return strings.EqualFold(token, keyword) || (keyword == "AND" && token == "&&") || (keyword == "OR" && token == "||")
+}
+
+// parseBlock parses `( ( !(ActionMatches{'...'}) AND ... ) OR ( @Source[...] Operator Value ) )`
+func (p *roleAssignmentConditionParser) parseBlock() (*roleAssignmentCondition, error) {
+	output := roleAssignmentCondition{
+		Actions: make([]string, 0),
+		Values:  make([]string, 0),
+	}
+
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	for {
+		action, err := p.parseAction()
+		if err != nil {
+			return nil, err
+		}
+		output.Actions = append(output.Actions, action)
+
+		if !p.isKeyword("AND") {
+			break
+		}
+		if err := p.expectKeyword("AND"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	if err := p.expectKeyword("OR"); err != nil {
+		return nil, err
+	}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	attribute, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(attribute, "@") {
+		return nil, fmt.Errorf("expected an attribute but got %q", attribute)
+	}
+	output.Attribute = attribute
+
+	if output.Operator, err = p.next(); err != nil {
+		return nil, err
+	}
+
+	if p.peek() == "{" {
+		p.position++
+		for {
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			output.Values = append(output.Values, value)
+
+			if p.peek() != "," {
+				break
+			}
+			p.position++
+		}
+		if err := p.expect("}"); err != nil {
+			return nil, err
+		}
+	} else {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		output.Values = append(output.Values, value)
+	}
+
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// parseAction parses `!(ActionMatches{'...'})`
+func (p *roleAssignmentConditionParser) parseAction() (string, error) {
+	for _, expected := range []string{"!", "(", "ActionMatches", "{"} {
+		if err := p.expect(expected); err != nil {
+			return "", err
+		}
+	}
+
+	action, err := p.parseValue()
+	if err != nil {
+		return "", err
+	}
+
+	if err := p.expect("}"); err != nil {
+		return "", err
+	}
+	if p.isKeyword("AND") {
+		return "", fmt.Errorf("only conditions on whole actions are supported, `SubOperationMatches` and other compound action expressions can't be parsed")
+	}
+	if err := p.expect(")"); err != nil {
+		return "", err
+	}
+
+	return action, nil
+}
+
+func (p *roleAssignmentConditionParser) parseValue() (string, error) {
+	token, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	if strings.HasPrefix(token, "'") {
+		return strings.TrimSuffix(strings.TrimPrefix(token, "'"), "'"), nil
+	}
+	if strings.ContainsAny(token, "(){},!@") {
+		return "", fmt.Errorf("expected a value but got %q", token)
+	}
+	return token, nil
+}
+
+// tokenizeRoleAssignmentCondition splits the condition into punctuation, quoted strings, attributes and words
+func tokenizeRoleAssignmentCondition(input string) ([]string, error) {
+	tokens := make([]string, 0)
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+
+		case strings.IndexByte("(){},!", c) != -1:
+			tokens = append(tokens, string(c))
+			i++
+
+		case c == '\'':
+			end := strings.IndexByte(input[i+1:], '\'')
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated string starting at position %d", i)
+			}
+			tokens = append(tokens, input[i:i+end+2])
+			i += end + 2
+
+		case c == '@':
+			end := strings.IndexByte(input[i:], ']')
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated attribute starting at position %d", i)
+			}
+			tokens = append(tokens, input[i:i+end+1])
+			i += end + 1
+
+		default:
+			start := i
+			for i < len(input) && strings.IndexByte(" \t\r\n(){},!'", input[i]) == -1 {
+				i++
+			}
+			tokens = append(tokens, input[start:i])
+		}
+	}
+
+	return tokens, nil
+}
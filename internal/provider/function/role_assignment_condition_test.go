@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildRoleAssignmentCondition(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []roleAssignmentCondition
+		Expected string
+		Error    bool
+	}{
+		{
+			Name:  "empty",
+			Input: []roleAssignmentCondition{},
+			Error: true,
+		},
+		{
+			Name: "single string value",
+			Input: []roleAssignmentCondition{
+				{
+					Actions:   []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+					Attribute: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name]",
+					Operator:  "StringEquals",
+					Values:    []string{"logs"},
+				},
+			},
+			Expected: `(
+ (
+  !(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})
+ )
+ OR
+ (
+  @Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'logs'
+ )
+)`,
+		},
+		{
+			Name: "multiple actions and a cross product operator",
+			Input: []roleAssignmentCondition{
+				{
+					Actions: []string{
+						"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read",
+						"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/write",
+					},
+					Attribute: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers/blobs/tags:Project<$key_case_sensitive$>]",
+					Operator:  "ForAnyOfAnyValues:StringEquals",
+					Values:    []string{"alpha", "beta"},
+				},
+			},
+			Expected: `(
+ (
+  !(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})
+  AND
+  !(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/write'})
+ )
+ OR
+ (
+  @Resource[Microsoft.Storage/storageAccounts/blobServices/containers/blobs/tags:Project<$key_case_sensitive$>] ForAnyOfAnyValues:StringEquals {'alpha', 'beta'}
+ )
+)`,
+		},
+		{
+			Name: "multiple conditions with an unquoted value",
+			Input: []roleAssignmentCondition{
+				{
+					Actions:   []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+					Attribute: "@Environment[isPrivateLink]",
+					Operator:  "BoolEquals",
+					Values:    []string{"true"},
+				},
+				{
+					Actions:   []string{"Microsoft.KeyVault/vaults/secrets/getSecret/action"},
+					Attribute: "@Principal[Microsoft.Directory/CustomSecurityAttributes/Id:Engineering_Team]",
+					Operator:  "StringEqualsIgnoreCase",
+					Values:    []string{"platform"},
+				},
+			},
+			Expected: `(
+ (
+  !(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})
+ )
+ OR
+ (
+  @Environment[isPrivateLink] BoolEquals true
+ )
+)
+AND
+(
+ (
+  !(ActionMatches{'Microsoft.KeyVault/vaults/secrets/getSecret/action'})
+ )
+ OR
+ (
+  @Principal[Microsoft.Directory/CustomSecurityAttributes/Id:Engineering_Team] StringEqualsIgnoreCase 'platform'
+ )
+)`,
+		},
+		{
+			Name: "invalid action",
+			Input: []roleAssignmentCondition{
+				{
+					Actions:   []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs"},
+					Attribute: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name]",
+					Operator:  "StringEquals",
+					Values:    []string{"logs"},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "invalid attribute source",
+			Input: []roleAssignmentCondition{
+				{
+					Actions:   []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+					Attribute: "@Subject[Microsoft.Storage/storageAccounts/blobServices/containers:name]",
+					Operator:  "StringEquals",
+					Values:    []string{"logs"},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "invalid attribute name",
+			Input: []roleAssignmentCondition{
+				{
+					Actions:   []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+					Attribute: "@Resource[containerName]",
+					Operator:  "StringEquals",
+					Values:    []string{"logs"},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "unknown environment attribute",
+			Input: []roleAssignmentCondition{
+				{
+					Actions:   []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+					Attribute: "@Environment[clientIp]",
+					Operator:  "StringEquals",
+					Values:    []string{"10.0.0.1"},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "unknown operator",
+			Input: []roleAssignmentCondition{
+				{
+					Actions:   []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+					Attribute: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name]",
+					Operator:  "StringContains",
+					Values:    []string{"logs"},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "unknown cross product operator",
+			Input: []roleAssignmentCondition{
+				{
+					Actions:   []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+					Attribute: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name]",
+					Operator:  "ForSomeValues:StringEquals",
+					Values:    []string{"logs"},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "multiple values without a cross product operator",
+			Input: []roleAssignmentCondition{
+				{
+					Actions:   []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+					Attribute: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name]",
+					Operator:  "StringEquals",
+					Values:    []string{"logs", "audit"},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "invalid numeric value",
+			Input: []roleAssignmentCondition{
+				{
+					Actions:   []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+					Attribute: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers/blobs:versionId]",
+					Operator:  "NumericLessThan",
+					Values:    []string{"ten"},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "string value containing a quote",
+			Input: []roleAssignmentCondition{
+				{
+					Actions:   []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+					Attribute: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name]",
+					Operator:  "StringEquals",
+					Values:    []string{"it's"},
+				},
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := buildRoleAssignmentCondition(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but got %q", actual)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("expected:\n%s\n\nbut got:\n%s", v.Expected, actual)
+		}
+
+		parsed, err := parseRoleAssignmentCondition(actual)
+		if err != nil {
+			t.Fatalf("parsing the built condition: %+v", err)
+		}
+		if !reflect.DeepEqual(parsed, v.Input) {
+			t.Fatalf("expected the parsed condition to be %+v but got %+v", v.Input, parsed)
+		}
+	}
+}
+
+func TestParseRoleAssignmentCondition(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected []roleAssignmentCondition
+		Error    bool
+	}{
+		{
+			Name:  "empty",
+			Input: "  ",
+			Error: true,
+		},
+		{
+			Name:  "single line with symbols and lowercase keywords",
+			Input: `((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'}) && !(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/write'})) or (@Request[Microsoft.Storage/storageAccounts/blobServices/containers/blobs:path] StringLike 'reports/*'))`,
+			Expected: []roleAssignmentCondition{
+				{
+					Actions: []string{
+						"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read",
+						"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/write",
+					},
+					Attribute: "@Request[Microsoft.Storage/storageAccounts/blobServices/containers/blobs:path]",
+					Operator:  "StringLike",
+					Values:    []string{"reports/*"},
+				},
+			},
+		},
+		{
+			Name:  "string containing spaces",
+			Input: `((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers/blobs/tags:Project<$key_case_sensitive$>] StringEquals 'Project Alpha'))`,
+			Expected: []roleAssignmentCondition{
+				{
+					Actions:   []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+					Attribute: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers/blobs/tags:Project<$key_case_sensitive$>]",
+					Operator:  "StringEquals",
+					Values:    []string{"Project Alpha"},
+				},
+			},
+		},
+		{
+			Name:  "date time value",
+			Input: `((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})) OR (@Environment[UtcNow] DateTimeLessThan '2030-01-01T00:00:00.0Z'))`,
+			Expected: []roleAssignmentCondition{
+				{
+					Actions:   []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+					Attribute: "@Environment[UtcNow]",
+					Operator:  "DateTimeLessThan",
+					Values:    []string{"2030-01-01T00:00:00.0Z"},
+				},
+			},
+		},
+		{
+			Name:  "sub operation",
+			Input: `((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'} AND NOT SubOperationMatches{'Blob.List'})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'logs'))`,
+			Error: true,
+		},
+		{
+			Name:  "missing expression",
+			Input: `((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})))`,
+			Error: true,
+		},
+		{
+			Name:  "unterminated string",
+			Input: `((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'logs'))`,
+			Error: true,
+		},
+		{
+			Name:  "trailing content",
+			Input: `((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'logs')) OR`,
+			Error: true,
+		},
+		{
+			Name:  "invalid operator",
+			Input: `((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] Equals 'logs'))`,
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := parseRoleAssignmentCondition(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but got %+v", actual)
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
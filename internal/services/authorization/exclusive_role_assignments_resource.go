@@ -0,0 +1,503 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ExclusiveRoleAssignmentsResource struct{}
+
+var errExclusiveRoleAssignmentsScopeNotFound = errors.New("scope was not found")
+
+var (
+	_ sdk.ResourceWithUpdate        = ExclusiveRoleAssignmentsResource{}
+	_ sdk.ResourceWithCustomizeDiff = ExclusiveRoleAssignmentsResource{}
+)
+
+type ExclusiveRoleAssignmentsResourceModel struct {
+	Scope                     string                                    `tfschema:"scope"`
+	RoleDefinitionIds         []string                                  `tfschema:"role_definition_ids"`
+	RoleAssignment            []ExclusiveRoleAssignmentsAssignmentModel `tfschema:"role_assignment"`
+	AllowEmptyRoleAssignments bool                                      `tfschema:"allow_empty_role_assignments"`
+}
+
+type ExclusiveRoleAssignmentsAssignmentModel struct {
+	PrincipalId      string `tfschema:"principal_id"`
+	RoleDefinitionId string `tfschema:"role_definition_id"`
+	Condition        string `tfschema:"condition"`
+	ConditionVersion string `tfschema:"condition_version"`
+}
+
+func (r ExclusiveRoleAssignmentsResource) ResourceType() string {
+	return "azurerm_exclusive_role_assignments"
+}
+
+func (r ExclusiveRoleAssignmentsResource) ModelObject() interface{} {
+	return &ExclusiveRoleAssignmentsResourceModel{}
+}
+
+func (r ExclusiveRoleAssignmentsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateScopeID
+}
+
+func (r ExclusiveRoleAssignmentsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"role_definition_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"role_assignment": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"principal_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"role_definition_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"condition": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"condition_version": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"1.0",
+							"2.0",
+						}, false),
+					},
+				},
+			},
+		},
+
+		"allow_empty_role_assignments": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r ExclusiveRoleAssignmentsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ExclusiveRoleAssignmentsResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config ExclusiveRoleAssignmentsResourceModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, v := range config.RoleAssignment {
+				if v.ConditionVersion != "" && v.Condition == "" {
+					return fmt.Errorf("`condition_version` cannot be set without `condition` for the Role Assignment of %q", v.PrincipalId)
+				}
+				// the Role Definition ID may not be known until apply
+				if v.RoleDefinitionId == "" {
+					continue
+				}
+				if !exclusiveRoleAssignmentsInFilter(v.RoleDefinitionId, config.RoleDefinitionIds) {
+					return fmt.Errorf("the Role Assignment of %q to %q is not covered by `role_definition_ids` and would be removed on the next apply", v.PrincipalId, v.RoleDefinitionId)
+				}
+			}
+
+			if metadata.ResourceDiff.NewValueKnown("role_assignment") {
+				if err := validateExclusiveRoleAssignmentsNotEmpty(config); err != nil {
+					return err
+				}
+			}
+
+			// the Role Assignments which already exist at the scope aren't in the state prior to creation, so they'd
+			// never be shown in the plan as ones to remove - instead the scope has to be imported first
+			if metadata.ResourceDiff.Id() != "" || !metadata.ResourceDiff.NewValueKnown("scope") {
+				return nil
+			}
+			for _, v := range config.RoleAssignment {
+				// the Role Assignments can't be compared until every declared Role Assignment is known, in which case
+				// this is checked again during the apply
+				if v.PrincipalId == "" || v.RoleDefinitionId == "" {
+					return nil
+				}
+			}
+
+			id := commonids.NewScopeID(config.Scope)
+			existing, err := r.listAtScope(ctx, metadata, id, config.RoleDefinitionIds)
+			if err != nil {
+				if errors.Is(err, errExclusiveRoleAssignmentsScopeNotFound) {
+					return nil
+				}
+				return err
+			}
+
+			_, toDelete := diffExclusiveRoleAssignments(config.RoleAssignment, existing)
+			return checkExclusiveRoleAssignmentsUnmanaged(id, toDelete)
+		},
+	}
+}
+
+func (r ExclusiveRoleAssignmentsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config ExclusiveRoleAssignmentsResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := commonids.NewScopeID(config.Scope)
+
+			existing, err := r.listAtScope(ctx, metadata, id, config.RoleDefinitionIds)
+			if err != nil {
+				return err
+			}
+
+			// this is also checked during the plan, but is repeated here for the case where the configuration
+			// wasn't known until the apply
+			if _, toDelete := diffExclusiveRoleAssignments(config.RoleAssignment, existing); len(toDelete) > 0 {
+				return checkExclusiveRoleAssignmentsUnmanaged(id, toDelete)
+			}
+
+			if err := r.reconcile(ctx, metadata, id, config); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ExclusiveRoleAssignmentsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseScopeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ExclusiveRoleAssignmentsResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := r.listAtScope(ctx, metadata, *id, config.RoleDefinitionIds)
+			if err != nil {
+				return err
+			}
+
+			// every assignment at the scope which is covered by the role filter is written to the state, so that any
+			// assignment which was made out-of-band shows up in the plan as one which is going to be removed
+			state := ExclusiveRoleAssignmentsResourceModel{
+				Scope:                     id.Scope,
+				RoleDefinitionIds:         config.RoleDefinitionIds,
+				RoleAssignment:            make([]ExclusiveRoleAssignmentsAssignmentModel, 0),
+				AllowEmptyRoleAssignments: config.AllowEmptyRoleAssignments,
+			}
+			for _, v := range existing {
+				state.RoleAssignment = append(state.RoleAssignment, flattenExclusiveRoleAssignmentsAssignment(v, config.RoleAssignment))
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ExclusiveRoleAssignmentsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseScopeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ExclusiveRoleAssignmentsResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return r.reconcile(ctx, metadata, *id, config)
+		},
+	}
+}
+
+func (r ExclusiveRoleAssignmentsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// removing exclusive management leaves the Role Assignments in place, since deleting them could lock
+			// the operator out of the scope
+			return nil
+		},
+	}
+}
+
+// reconcile creates any declared Role Assignment which doesn't exist yet, and then removes every Role Assignment at
+// the scope which is covered by the role filter but not declared in the configuration. The Role Assignments are
+// created first so that a failure part way through never leaves the scope with fewer Role Assignments than intended.
+func (r ExclusiveRoleAssignmentsResource) reconcile(ctx context.Context, metadata sdk.ResourceMetaData, id commonids.ScopeId, config ExclusiveRoleAssignmentsResourceModel) error {
+	client := metadata.Client.Authorization.ScopedRoleAssignmentsClient
+
+	if err := validateExclusiveRoleAssignmentsNotEmpty(config); err != nil {
+		return err
+	}
+
+	existing, err := r.listAtScope(ctx, metadata, id, config.RoleDefinitionIds)
+	if err != nil {
+		return err
+	}
+
+	toCreate, toDelete := diffExclusiveRoleAssignments(config.RoleAssignment, existing)
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("could not retrieve context deadline for %s", id)
+	}
+
+	for _, v := range toCreate {
+		name, err := uuid.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("generating UUID for Role Assignment: %+v", err)
+		}
+
+		assignmentId := parse.NewScopedRoleAssignmentID(id.Scope, name, "")
+		properties := roleassignments.RoleAssignmentCreateParameters{
+			Properties: roleassignments.RoleAssignmentProperties{
+				PrincipalId:      v.PrincipalId,
+				RoleDefinitionId: v.RoleDefinitionId,
+			},
+		}
+		if v.Condition != "" {
+			properties.Properties.Condition = pointer.To(v.Condition)
+			properties.Properties.ConditionVersion = pointer.To(exclusiveRoleAssignmentsConditionVersion(v.ConditionVersion))
+		}
+
+		log.Printf("[DEBUG] Creating %s for %q..", assignmentId, v.PrincipalId)
+		if err := pluginsdk.Retry(time.Until(deadline), roleAssignmentBaseResource{}.retryRoleAssignmentsClient(ctx, metadata, assignmentId, &properties)); err != nil {
+			return fmt.Errorf("creating Role Assignment of %q to %q at %s: %+v", v.RoleDefinitionId, v.PrincipalId, id, err)
+		}
+	}
+
+	for _, v := range toDelete {
+		assignmentId := commonids.NewScopeID(pointer.From(v.Id))
+		log.Printf("[DEBUG] Removing unmanaged Role Assignment %q from %s..", assignmentId.Scope, id)
+		resp, err := client.DeleteById(ctx, assignmentId, roleassignments.DefaultDeleteByIdOperationOptions())
+		if err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("removing unmanaged Role Assignment %q from %s: %+v", assignmentId.Scope, id, err)
+		}
+	}
+
+	return nil
+}
+
+// listAtScope returns the Role Assignments made directly at the scope which are covered by the role filter. Role
+// Assignments which are inherited from a parent scope can't be removed here, so they're never returned.
+func (r ExclusiveRoleAssignmentsResource) listAtScope(ctx context.Context, metadata sdk.ResourceMetaData, id commonids.ScopeId, roleDefinitionIds []string) ([]roleassignments.RoleAssignment, error) {
+	client := metadata.Client.Authorization.ScopedRoleAssignmentsClient
+
+	options := roleassignments.DefaultListForScopeOperationOptions()
+	options.Filter = pointer.To("atScope()")
+
+	resp, err := client.ListForScopeComplete(ctx, id, options)
+	if err != nil {
+		if response.WasNotFound(resp.LatestHttpResponse) {
+			return nil, fmt.Errorf("listing Role Assignments for %s: %w", id, errExclusiveRoleAssignmentsScopeNotFound)
+		}
+		return nil, fmt.Errorf("listing Role Assignments for %s: %+v", id, err)
+	}
+
+	output := make([]roleassignments.RoleAssignment, 0)
+	for _, v := range resp.Items {
+		if v.Properties == nil || !strings.EqualFold(normalizeExclusiveRoleAssignmentsScope(pointer.From(v.Properties.Scope)), normalizeExclusiveRoleAssignmentsScope(id.Scope)) {
+			continue
+		}
+		if !exclusiveRoleAssignmentsInFilter(v.Properties.RoleDefinitionId, roleDefinitionIds) {
+			continue
+		}
+		output = append(output, v)
+	}
+
+	return output, nil
+}
+
+// validateExclusiveRoleAssignmentsNotEmpty ensures that removing every Role Assignment at the scope which is covered
+// by the role filter is intentional, since it's easy to do by accident and can lock the operator out of the scope.
+func validateExclusiveRoleAssignmentsNotEmpty(config ExclusiveRoleAssignmentsResourceModel) error {
+	if len(config.RoleAssignment) > 0 || config.AllowEmptyRoleAssignments {
+		return nil
+	}
+
+	if len(config.RoleDefinitionIds) == 0 {
+		return fmt.Errorf("no `role_assignment` blocks are specified, which would remove every Role Assignment at %q - set `allow_empty_role_assignments` to `true` if this is intended", config.Scope)
+	}
+	return fmt.Errorf("no `role_assignment` blocks are specified, which would remove every Role Assignment at %q for the roles in `role_definition_ids` - set `allow_empty_role_assignments` to `true` if this is intended", config.Scope)
+}
+
+// checkExclusiveRoleAssignmentsUnmanaged returns an error listing the Role Assignments which already exist at the
+// scope and would be removed, since these aren't shown in the plan until the scope has been imported.
+func checkExclusiveRoleAssignmentsUnmanaged(id commonids.ScopeId, unmanaged []roleassignments.RoleAssignment) error {
+	if len(unmanaged) == 0 {
+		return nil
+	}
+
+	assignments := make([]string, 0, len(unmanaged))
+	for _, v := range unmanaged {
+		if props := v.Properties; props != nil {
+			assignments = append(assignments, fmt.Sprintf("%s (Principal %q, Role Definition %q)", pointer.From(v.Id), props.PrincipalId, props.RoleDefinitionId))
+			continue
+		}
+		assignments = append(assignments, pointer.From(v.Id))
+	}
+
+	return fmt.Errorf("%s has %d existing Role Assignment(s) which aren't declared in the configuration and would be removed:\n\n%s\n\nEither declare these in a `role_assignment` block, or import %q into the State first so that their removal is shown in the plan", id, len(unmanaged), strings.Join(assignments, "\n"), id.ID())
+}
+
+// diffExclusiveRoleAssignments compares the declared Role Assignments with those which exist at the scope, returning
+// the declared Role Assignments which need to be created and the existing Role Assignments which need to be removed.
+func diffExclusiveRoleAssignments(desired []ExclusiveRoleAssignmentsAssignmentModel, existing []roleassignments.RoleAssignment) ([]ExclusiveRoleAssignmentsAssignmentModel, []roleassignments.RoleAssignment) {
+	existingKeys := make(map[string]struct{})
+	for _, v := range existing {
+		existingKeys[exclusiveRoleAssignmentsKeyFromApi(v)] = struct{}{}
+	}
+
+	desiredKeys := make(map[string]struct{})
+	toCreate := make([]ExclusiveRoleAssignmentsAssignmentModel, 0)
+	for _, v := range desired {
+		key := exclusiveRoleAssignmentsKey(v.PrincipalId, v.RoleDefinitionId, v.Condition, v.ConditionVersion)
+		desiredKeys[key] = struct{}{}
+		if _, ok := existingKeys[key]; !ok {
+			toCreate = append(toCreate, v)
+		}
+	}
+
+	toDelete := make([]roleassignments.RoleAssignment, 0)
+	for _, v := range existing {
+		if _, ok := desiredKeys[exclusiveRoleAssignmentsKeyFromApi(v)]; !ok {
+			toDelete = append(toDelete, v)
+		}
+	}
+
+	return toCreate, toDelete
+}
+
+// flattenExclusiveRoleAssignmentsAssignment flattens an existing Role Assignment, preferring the declared values where
+// the Role Assignment matches one in the configuration so that equivalent Role Definition IDs don't cause a diff.
+func flattenExclusiveRoleAssignmentsAssignment(input roleassignments.RoleAssignment, declared []ExclusiveRoleAssignmentsAssignmentModel) ExclusiveRoleAssignmentsAssignmentModel {
+	key := exclusiveRoleAssignmentsKeyFromApi(input)
+	for _, v := range declared {
+		if exclusiveRoleAssignmentsKey(v.PrincipalId, v.RoleDefinitionId, v.Condition, v.ConditionVersion) == key {
+			return v
+		}
+	}
+
+	output := ExclusiveRoleAssignmentsAssignmentModel{}
+	if props := input.Properties; props != nil {
+		output.PrincipalId = props.PrincipalId
+		output.RoleDefinitionId = props.RoleDefinitionId
+		output.Condition = pointer.From(props.Condition)
+		if output.Condition != "" {
+			output.ConditionVersion = pointer.From(props.ConditionVersion)
+		}
+	}
+	return output
+}
+
+func exclusiveRoleAssignmentsKeyFromApi(input roleassignments.RoleAssignment) string {
+	if input.Properties == nil {
+		return strings.ToLower(pointer.From(input.Id))
+	}
+	props := input.Properties
+	return exclusiveRoleAssignmentsKey(props.PrincipalId, props.RoleDefinitionId, pointer.From(props.Condition), pointer.From(props.ConditionVersion))
+}
+
+func exclusiveRoleAssignmentsKey(principalId, roleDefinitionId, condition, conditionVersion string) string {
+	if condition == "" {
+		conditionVersion = ""
+	} else {
+		conditionVersion = exclusiveRoleAssignmentsConditionVersion(conditionVersion)
+	}
+	return strings.Join([]string{
+		strings.ToLower(principalId),
+		exclusiveRoleAssignmentsRoleDefinitionName(roleDefinitionId),
+		condition,
+		conditionVersion,
+	}, "|")
+}
+
+func exclusiveRoleAssignmentsConditionVersion(input string) string {
+	if input == "" {
+		return "2.0"
+	}
+	return input
+}
+
+// exclusiveRoleAssignmentsRoleDefinitionName returns the GUID of a Role Definition, since the same Role Definition can
+// be referenced by IDs scoped to the tenant, a Management Group or a Subscription.
+func exclusiveRoleAssignmentsRoleDefinitionName(input string) string {
+	input = strings.TrimSuffix(input, "/")
+	return strings.ToLower(input[strings.LastIndex(input, "/")+1:])
+}
+
+func exclusiveRoleAssignmentsInFilter(roleDefinitionId string, filter []string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	name := exclusiveRoleAssignmentsRoleDefinitionName(roleDefinitionId)
+	for _, v := range filter {
+		if exclusiveRoleAssignmentsRoleDefinitionName(v) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeExclusiveRoleAssignmentsScope(input string) string {
+	if input == "/" {
+		return input
+	}
+	return strings.TrimSuffix(input, "/")
+}
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ExclusiveRoleAssignmentsResource struct{}
+
+func TestAccExclusiveRoleAssignments_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_exclusive_role_assignments", "test")
+	r := ExclusiveRoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("role_definition_ids"),
+	})
+}
+
+func TestAccExclusiveRoleAssignments_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_exclusive_role_assignments", "test")
+	r := ExclusiveRoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("role_definition_ids"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("role_definition_ids"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("role_definition_ids"),
+	})
+}
+
+func TestAccExclusiveRoleAssignments_removesUnmanaged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_exclusive_role_assignments", "test")
+	r := ExclusiveRoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClientForResource(r.assignOutOfBand, "azurerm_user_assigned_identity.second"),
+			),
+			// the out-of-band Role Assignment is picked up by the refresh and shown as one to remove
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccExclusiveRoleAssignments_existingUnmanaged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_exclusive_role_assignments", "test")
+	r := ExclusiveRoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.unmanagedTemplate(data),
+		},
+		{
+			// the existing Role Assignment isn't in the State yet, so creating the resource must fail rather than
+			// removing it without it having been shown in the plan
+			Config:      r.existingUnmanaged(data),
+			ExpectError: regexp.MustCompile("aren't declared in the configuration and would be removed"),
+		},
+	})
+}
+
+func TestAccExclusiveRoleAssignments_emptyRequiresOptIn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_exclusive_role_assignments", "test")
+	r := ExclusiveRoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.empty(data, false),
+			ExpectError: regexp.MustCompile("set `allow_empty_role_assignments` to `true` if this is intended"),
+		},
+		{
+			Config: r.empty(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("role_definition_ids", "allow_empty_role_assignments"),
+	})
+}
+
+func (r ExclusiveRoleAssignmentsResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseScopeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	options := roleassignments.DefaultListForScopeOperationOptions()
+	options.Filter = pointer.To("atScope()")
+
+	if _, err := client.Authorization.ScopedRoleAssignmentsClient.ListForScopeComplete(ctx, *id, options); err != nil {
+		return nil, fmt.Errorf("listing Role Assignments for %s: %+v", *id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+// assignOutOfBand assigns the Monitoring Reader role, which is covered by the role filter but not declared, to the
+// second User Assigned Identity at the Resource Group scope
+func (r ExclusiveRoleAssignmentsResource) assignOutOfBand(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+	name, err := uuid.GenerateUUID()
+	if err != nil {
+		return err
+	}
+	scope := commonids.NewResourceGroupID(client.Account.SubscriptionId, state.Attributes["resource_group_name"])
+	id := roleassignments.NewScopedRoleAssignmentID(scope.ID(), name)
+
+	payload := roleassignments.RoleAssignmentCreateParameters{
+		Properties: roleassignments.RoleAssignmentProperties{
+			PrincipalId:      state.Attributes["principal_id"],
+			PrincipalType:    pointer.To(roleassignments.PrincipalTypeServicePrincipal),
+			RoleDefinitionId: fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Authorization/roleDefinitions/43d0d8ad-25c7-4714-9337-8ba259a9fe05", client.Account.SubscriptionId),
+		},
+	}
+
+	if _, err := client.Authorization.ScopedRoleAssignmentsClient.Create(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating out-of-band %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func (r ExclusiveRoleAssignmentsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_exclusive_role_assignments" "test" {
+  scope = azurerm_resource_group.test.id
+
+  role_definition_ids = [
+    data.azurerm_role_definition.reader.id,
+    data.azurerm_role_definition.monitoring_reader.id,
+  ]
+
+  role_assignment {
+    principal_id       = azurerm_user_assigned_identity.first.principal_id
+    role_definition_id = data.azurerm_role_definition.reader.id
+  }
+}
+`, r.template(data))
+}
+
+func (r ExclusiveRoleAssignmentsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_exclusive_role_assignments" "test" {
+  scope = azurerm_resource_group.test.id
+
+  role_definition_ids = [
+    data.azurerm_role_definition.reader.id,
+    data.azurerm_role_definition.monitoring_reader.id,
+  ]
+
+  role_assignment {
+    principal_id       = azurerm_user_assigned_identity.first.principal_id
+    role_definition_id = data.azurerm_role_definition.reader.id
+  }
+
+  role_assignment {
+    principal_id       = azurerm_user_assigned_identity.second.principal_id
+    role_definition_id = data.azurerm_role_definition.monitoring_reader.id
+  }
+}
+`, r.template(data))
+}
+
+func (r ExclusiveRoleAssignmentsResource) empty(data acceptance.TestData, allowEmpty bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_exclusive_role_assignments" "test" {
+  scope = azurerm_resource_group.test.id
+
+  role_definition_ids = [
+    data.azurerm_role_definition.monitoring_reader.id,
+  ]
+
+  allow_empty_role_assignments = %t
+}
+`, r.template(data), allowEmpty)
+}
+
+func (r ExclusiveRoleAssignmentsResource) existingUnmanaged(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_exclusive_role_assignments" "test" {
+  scope = azurerm_resource_group.test.id
+
+  role_definition_ids = [
+    data.azurerm_role_definition.reader.id,
+    data.azurerm_role_definition.monitoring_reader.id,
+  ]
+
+  role_assignment {
+    principal_id       = azurerm_user_assigned_identity.first.principal_id
+    role_definition_id = data.azurerm_role_definition.reader.id
+  }
+}
+`, r.unmanagedTemplate(data))
+}
+
+func (r ExclusiveRoleAssignmentsResource) unmanagedTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_assignment" "unmanaged" {
+  scope              = azurerm_resource_group.test.id
+  role_definition_id = data.azurerm_role_definition.monitoring_reader.id
+  principal_id       = azurerm_user_assigned_identity.second.principal_id
+}
+`, r.template(data))
+}
+
+func (ExclusiveRoleAssignmentsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+data "azurerm_role_definition" "reader" {
+  name  = "Reader"
+  scope = data.azurerm_subscription.current.id
+}
+
+data "azurerm_role_definition" "monitoring_reader" {
+  name  = "Monitoring Reader"
+  scope = data.azurerm_subscription.current.id
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-role-assignments-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_user_assigned_identity" "first" {
+  name                = "acctest-uai-first-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_user_assigned_identity" "second" {
+  name                = "acctest-uai-second-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
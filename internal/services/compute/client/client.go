@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -24,6 +25,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/galleryimages"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/gallerysharingupdate"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-03-01/virtualmachineruncommands"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-03-01/virtualmachinescalesetvmruncommands"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-07-03/galleryimageversions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/availabilitysets"
@@ -41,6 +43,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/marketplaceordering/2015-06-01/agreements"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/standbypool/2025-03-01/standbyvirtualmachinepools"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -77,7 +80,10 @@ type Client struct {
 	VirtualMachineScaleSetExtensionsClient      *virtualmachinescalesetextensions.VirtualMachineScaleSetExtensionsClient
 	VirtualMachineScaleSetRollingUpgradesClient *virtualmachinescalesetrollingupgrades.VirtualMachineScaleSetRollingUpgradesClient
 	VirtualMachineScaleSetVMsClient             *virtualmachinescalesetvms.VirtualMachineScaleSetVMsClient
+	VirtualMachineScaleSetVMRunCommandsClient   *virtualmachinescalesetvmruncommands.VirtualMachineScaleSetVMRunCommandsClient
 	VirtualMachineImagesClient                  *virtualmachineimages.VirtualMachineImagesClient
+
+	options *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -237,6 +243,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(virtualMachineRunCommandsClient.Client, o.Authorizers.ResourceManager)
 
+	virtualMachineScaleSetVMRunCommandsClient, err := virtualmachinescalesetvmruncommands.NewVirtualMachineScaleSetVMRunCommandsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building VirtualMachineScaleSetVMRunCommands client: %+v", err)
+	}
+	o.Configure(virtualMachineScaleSetVMRunCommandsClient.Client, o.Authorizers.ResourceManager)
+
 	virtualMachineScaleSetRollingUpgradesClient, err := virtualmachinescalesetrollingupgrades.NewVirtualMachineScaleSetRollingUpgradesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building VirtualMachineScaleSetRollingUpgrades client: %+v", err)
@@ -298,10 +310,41 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		VirtualMachineScaleSetExtensionsClient:      virtualMachineScaleSetExtensionsClient,
 		VirtualMachineScaleSetRollingUpgradesClient: virtualMachineScaleSetRollingUpgradesClient,
 		VirtualMachineScaleSetVMsClient:             virtualMachineScaleSetVMsClient,
+		VirtualMachineScaleSetVMRunCommandsClient:   virtualMachineScaleSetVMRunCommandsClient,
 		VirtualMachineImagesClient:                  vmImageClient,
+
+		options: o,
 	}, nil
 }
 
+// GalleryImageVersionsClientWithAuxiliaryTenants returns a GalleryImageVersionsClient which also obtains tokens for the
+// specified tenants, this is needed when the source of an Image Version is a Gallery in another tenant
+func (c *Client) GalleryImageVersionsClientWithAuxiliaryTenants(ctx context.Context, tenantIds []string) (*galleryimageversions.GalleryImageVersionsClient, error) {
+	if len(tenantIds) == 0 {
+		return c.GalleryImageVersionsClient, nil
+	}
+
+	if c.options.AuthConfig == nil {
+		return nil, fmt.Errorf("building an authorizer for the auxiliary tenants %v: the provider credentials are not available", tenantIds)
+	}
+
+	credentials := *c.options.AuthConfig
+	credentials.AuxiliaryTenantIDs = append(slices.Clone(credentials.AuxiliaryTenantIDs), tenantIds...)
+
+	authorizer, err := auth.NewAuthorizerFromCredentials(ctx, credentials, c.options.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building an authorizer for the auxiliary tenants %v: %+v", tenantIds, err)
+	}
+
+	client, err := galleryimageversions.NewGalleryImageVersionsClientWithBaseURI(c.options.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building GalleryImageVersions client: %+v", err)
+	}
+	c.options.Configure(client.Client, authorizer)
+
+	return client, nil
+}
+
 func (c *Client) CancelRollingUpgradesBeforeDeletion(ctx context.Context, id virtualmachinescalesets.VirtualMachineScaleSetId) error {
 	// TODO replace with commonid once https://github.com/hashicorp/pandora/issues/4017 has been merged
 	virtualMachineScaleSetId := virtualmachinescalesetrollingupgrades.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroupName, id.VirtualMachineScaleSetName)
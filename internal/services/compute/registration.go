@@ -90,6 +90,7 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		VirtualMachineImplicitDataDiskFromSourceResource{},
 		VirtualMachineRunCommandResource{},
+		VirtualMachineScaleSetRunCommandResource{},
 		GalleryApplicationResource{},
 		GalleryApplicationVersionResource{},
 		RestorePointCollectionResource{},
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+// runCommandOutputLogInterval is how often the output of a Run Command is retrieved whilst it's running
+const runCommandOutputLogInterval = 30 * time.Second
+
+// runCommandContentHash returns a hash of the fields which determine what a Run Command executes, this is
+// used to decide whether a one-shot Run Command needs to be run again.
+func runCommandContentHash(source []VirtualMachineRunCommandScriptSourceSchema, parameters []VirtualMachineRunCommandInputParameterSchema, protectedParameters []VirtualMachineRunCommandInputParameterSchema, runAsUser string) string {
+	content := struct {
+		CommandId           string                                         `json:"commandId"`
+		Script              string                                         `json:"script"`
+		ScriptUri           string                                         `json:"scriptUri"`
+		Parameters          []VirtualMachineRunCommandInputParameterSchema `json:"parameters"`
+		ProtectedParameters []VirtualMachineRunCommandInputParameterSchema `json:"protectedParameters"`
+		RunAsUser           string                                         `json:"runAsUser"`
+	}{
+		Parameters:          parameters,
+		ProtectedParameters: protectedParameters,
+		RunAsUser:           runAsUser,
+	}
+
+	if len(source) > 0 {
+		content.CommandId = source[0].CommandId
+		content.Script = source[0].Script
+		content.ScriptUri = source[0].ScriptUri
+	}
+
+	// marshalling a struct of strings and slices of strings can't fail
+	b, _ := json.Marshal(content)
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// runCommandContentFields are the arguments which are included in the content hash of a Run Command
+var runCommandContentFields = []string{
+	"source",
+	"parameter",
+	"protected_parameter",
+	"run_as_user",
+}
+
+// checkRunCommandExitCode returns an error when `expectedExitCodes` is specified and the Run Command exited with any other code
+func checkRunCommandExitCode(exitCode *int64, expectedExitCodes []int64, output string, errorMessage string) error {
+	if len(expectedExitCodes) == 0 {
+		return nil
+	}
+
+	if exitCode == nil {
+		return fmt.Errorf("the exit code of the command was not returned, expected one of %v", expectedExitCodes)
+	}
+
+	if slices.Contains(expectedExitCodes, *exitCode) {
+		return nil
+	}
+
+	details := make([]string, 0)
+	if errorMessage = strings.TrimSpace(errorMessage); errorMessage != "" {
+		details = append(details, fmt.Sprintf("error: %s", errorMessage))
+	}
+	if output = strings.TrimSpace(output); output != "" {
+		details = append(details, fmt.Sprintf("output: %s", output))
+	}
+
+	message := fmt.Sprintf("the command exited with code %d, expected one of %v", *exitCode, expectedExitCodes)
+	if len(details) > 0 {
+		message = fmt.Sprintf("%s\n\n%s", message, strings.Join(details, "\n\n"))
+	}
+
+	return errors.New(message)
+}
+
+// pollRunCommandUntilDone waits for the Run Command to finish, logging any output produced whilst it's running
+// so that long-running scripts can be followed with `TF_LOG=INFO` rather than only once the run has completed.
+func pollRunCommandUntilDone(ctx context.Context, poller pollers.Poller, name string, getOutput func(ctx context.Context) (string, error)) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- poller.PollUntilDone(ctx)
+	}()
+
+	ticker := time.NewTicker(runCommandOutputLogInterval)
+	defer ticker.Stop()
+
+	logged := 0
+	for {
+		select {
+		case err := <-done:
+			return err
+		case <-ticker.C:
+			output, err := getOutput(ctx)
+			if err != nil {
+				log.Printf("[DEBUG] retrieving the output of %s: %+v", name, err)
+				continue
+			}
+
+			if len(output) > logged {
+				log.Printf("[INFO] output from %s:\n%s", name, output[logged:])
+				logged = len(output)
+			}
+		}
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestRunCommandContentHash(t *testing.T) {
+	source := []VirtualMachineRunCommandScriptSourceSchema{
+		{
+			Script: "echo 'hello world'",
+		},
+	}
+	parameters := []VirtualMachineRunCommandInputParameterSchema{
+		{
+			Name:  "foo",
+			Value: "bar",
+		},
+	}
+
+	hash := runCommandContentHash(source, parameters, nil, "")
+	if hash != runCommandContentHash(source, parameters, nil, "") {
+		t.Fatalf("expected the hash to be stable for the same content")
+	}
+
+	// the managed identity used to fetch the script doesn't change what's run
+	withIdentity := []VirtualMachineRunCommandScriptSourceSchema{
+		{
+			Script: "echo 'hello world'",
+			ScriptUriManagedIdentity: []VirtualMachineRunCommandManagedIdentitySchema{
+				{
+					ClientId: "00000000-0000-0000-0000-000000000000",
+				},
+			},
+		},
+	}
+	if hash != runCommandContentHash(withIdentity, parameters, nil, "") {
+		t.Fatalf("expected the hash to ignore the script managed identity")
+	}
+
+	changes := map[string]string{
+		"script": runCommandContentHash([]VirtualMachineRunCommandScriptSourceSchema{{Script: "echo 'goodbye'"}}, parameters, nil, ""),
+		"parameter": runCommandContentHash(source, []VirtualMachineRunCommandInputParameterSchema{
+			{
+				Name:  "foo",
+				Value: "baz",
+			},
+		}, nil, ""),
+		"protected_parameter": runCommandContentHash(source, parameters, []VirtualMachineRunCommandInputParameterSchema{
+			{
+				Name:  "secret",
+				Value: "value",
+			},
+		}, ""),
+		"run_as_user": runCommandContentHash(source, parameters, nil, "adminuser"),
+	}
+	for field, v := range changes {
+		if v == hash {
+			t.Fatalf("expected the hash to change when `%s` changes", field)
+		}
+	}
+}
+
+func TestCheckRunCommandExitCode(t *testing.T) {
+	testData := []struct {
+		name              string
+		exitCode          *int64
+		expectedExitCodes []int64
+		errorContains     []string
+	}{
+		{
+			name:     "no expected exit codes",
+			exitCode: pointer.To(int64(1)),
+		},
+		{
+			name:              "expected exit code",
+			exitCode:          pointer.To(int64(3010)),
+			expectedExitCodes: []int64{0, 3010},
+		},
+		{
+			name:              "unexpected exit code",
+			exitCode:          pointer.To(int64(1)),
+			expectedExitCodes: []int64{0},
+			errorContains:     []string{"exited with code 1", "error: something went wrong", "output: partial output"},
+		},
+		{
+			name:              "missing exit code",
+			expectedExitCodes: []int64{0},
+			errorContains:     []string{"was not returned"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		err := checkRunCommandExitCode(v.exitCode, v.expectedExitCodes, "partial output\n", "something went wrong")
+		if len(v.errorContains) == 0 {
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		for _, s := range v.errorContains {
+			if !strings.Contains(err.Error(), s) {
+				t.Fatalf("expected the error %q to contain %q", err.Error(), s)
+			}
+		}
+	}
+}
+
+func TestRunCommandUntargetedInstanceIds(t *testing.T) {
+	// the prior state of `instance_result`, as returned from GetChange
+	previousState := []interface{}{
+		map[string]interface{}{
+			"instance_id": "0",
+			"exit_code":   0,
+		},
+		map[string]interface{}{
+			"instance_id": "1",
+			"exit_code":   0,
+		},
+		map[string]interface{}{
+			"instance_id": "2",
+			"exit_code":   1,
+		},
+	}
+
+	testData := []struct {
+		name     string
+		previous interface{}
+		current  []string
+		expected []string
+	}{
+		{
+			name:     "shrinking instance_ids",
+			previous: previousState,
+			current:  []string{"1"},
+			expected: []string{"0", "2"},
+		},
+		{
+			name:     "growing instance_ids",
+			previous: previousState,
+			current:  []string{"0", "1", "2", "3"},
+			expected: []string{},
+		},
+		{
+			name:     "no prior state",
+			previous: nil,
+			current:  []string{"0"},
+			expected: []string{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := runCommandUntargetedInstanceIds(runCommandPreviousInstanceIds(v.previous), v.current)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
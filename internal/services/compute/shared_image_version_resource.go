@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/go-autorest/autorest/date"
@@ -130,7 +131,7 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
 				RequiredWith: []string{"storage_account_id"},
-				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id"},
+				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id", "source_image_version_id"},
 			},
 
 			"storage_account_id": {
@@ -152,7 +153,7 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id"},
+				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id", "source_image_version_id"},
 				// TODO -- add a validation function when snapshot has its own validation function
 			},
 
@@ -164,7 +165,35 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 					images.ValidateImageID,
 					commonids.ValidateVirtualMachineID,
 				),
-				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id"},
+				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id", "source_image_version_id"},
+			},
+
+			"source_image_version_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: galleryimageversions.ValidateImageVersionID,
+				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id", "source_image_version_id"},
+			},
+
+			"auxiliary_tenant_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"replication_completion_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  sharedImageVersionReplicationCompletionModeAllRegions,
+				ValidateFunc: validation.StringInSlice([]string{
+					sharedImageVersionReplicationCompletionModeAllRegions,
+					sharedImageVersionReplicationCompletionModePrimaryRegion,
+				}, false),
 			},
 
 			"replication_mode": {
@@ -192,22 +221,69 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 			},
 
 			"tags": commonschema.Tags(),
+
+			"replication_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"replication_status": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"region": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"progress": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"details": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 
 		CustomizeDiff: pluginsdk.CustomDiffWithAll(
 			pluginsdk.ForceNewIfChange("end_of_life_date", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old.(string) != "" && new.(string) == ""
 			}),
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				// changing the target regions starts replicating again, so the status isn't known until apply
+				if d.Id() != "" && d.HasChange("target_region") {
+					if err := d.SetNewComputed("replication_state"); err != nil {
+						return err
+					}
+					return d.SetNewComputed("replication_status")
+				}
+				return nil
+			},
 		),
 	}
 }
 
 func resourceSharedImageVersionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleryImageVersionsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	client, err := meta.(*clients.Client).Compute.GalleryImageVersionsClientWithAuxiliaryTenants(ctx, pointer.From(utils.ExpandStringSlice(d.Get("auxiliary_tenant_ids").([]interface{}))))
+	if err != nil {
+		return err
+	}
+
 	id := galleryimageversions.NewImageVersionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("gallery_name").(string), d.Get("image_name").(string), d.Get("name").(string))
 
 	existing, err := client.Get(ctx, id, galleryimageversions.DefaultGetOperationOptions())
@@ -262,6 +338,12 @@ func resourceSharedImageVersionCreate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	if v, ok := d.GetOk("source_image_version_id"); ok {
+		version.Properties.StorageProfile.Source = &galleryimageversions.GalleryArtifactVersionFullSource{
+			Id: pointer.To(v.(string)),
+		}
+	}
+
 	if v, ok := d.GetOk("os_disk_snapshot_id"); ok {
 		version.Properties.StorageProfile.OsDiskImage = &galleryimageversions.GalleryDiskImage{
 			Source: &galleryimageversions.GalleryDiskImageSource{
@@ -279,7 +361,7 @@ func resourceSharedImageVersionCreate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, id, version); err != nil {
+	if err := createOrUpdateSharedImageVersion(ctx, client, id, version, d.Get("replication_completion_mode").(string)); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -289,10 +371,14 @@ func resourceSharedImageVersionCreate(d *pluginsdk.ResourceData, meta interface{
 }
 
 func resourceSharedImageVersionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleryImageVersionsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	client, err := meta.(*clients.Client).Compute.GalleryImageVersionsClientWithAuxiliaryTenants(ctx, pointer.From(utils.ExpandStringSlice(d.Get("auxiliary_tenant_ids").([]interface{}))))
+	if err != nil {
+		return err
+	}
+
 	id, err := galleryimageversions.ParseImageVersionID(d.Id())
 	if err != nil {
 		return err
@@ -342,7 +428,7 @@ func resourceSharedImageVersionUpdate(d *pluginsdk.ResourceData, meta interface{
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+	if err := createOrUpdateSharedImageVersion(ctx, client, *id, *payload, d.Get("replication_completion_mode").(string)); err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
@@ -361,7 +447,9 @@ func resourceSharedImageVersionRead(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	resp, err := client.Get(ctx, *id, galleryimageversions.DefaultGetOperationOptions())
+	resp, err := client.Get(ctx, *id, galleryimageversions.GetOperationOptions{
+		Expand: pointer.To(galleryimageversions.ReplicationStatusTypesReplicationStatus),
+	})
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s was not found - removing from state", id)
@@ -376,6 +464,13 @@ func resourceSharedImageVersionRead(d *pluginsdk.ResourceData, meta interface{})
 	d.Set("gallery_name", id.GalleryName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
+	// `auxiliary_tenant_ids` and `replication_completion_mode` only affect how Terraform creates the Image Version, so aren't returned by the API
+	replicationCompletionMode := d.Get("replication_completion_mode").(string)
+	if replicationCompletionMode == "" {
+		replicationCompletionMode = sharedImageVersionReplicationCompletionModeAllRegions
+	}
+	d.Set("replication_completion_mode", replicationCompletionMode)
+
 	if model := resp.Model; model != nil {
 		d.Set("location", location.Normalize(model.Location))
 
@@ -400,7 +495,12 @@ func resourceSharedImageVersionRead(d *pluginsdk.ResourceData, meta interface{})
 
 			if source := props.StorageProfile.Source; source != nil {
 				if source.Id != nil {
-					d.Set("managed_image_id", source.Id)
+					// the source is either a Managed Image or an Image Version in another Gallery
+					if sourceVersionId, err := galleryimageversions.ParseImageVersionIDInsensitively(*source.Id); err == nil {
+						d.Set("source_image_version_id", sourceVersionId.ID())
+					} else {
+						d.Set("managed_image_id", source.Id)
+					}
 				}
 
 				if source.VirtualMachineId != nil {
@@ -439,6 +539,17 @@ func resourceSharedImageVersionRead(d *pluginsdk.ResourceData, meta interface{})
 			if safetyProfile := props.SafetyProfile; safetyProfile != nil {
 				d.Set("deletion_of_replicated_locations_enabled", pointer.From(safetyProfile.AllowDeletionOfReplicatedLocations))
 			}
+
+			replicationState := ""
+			var replicationStatus *[]galleryimageversions.RegionalReplicationStatus
+			if status := props.ReplicationStatus; status != nil {
+				replicationState = string(pointer.From(status.AggregatedState))
+				replicationStatus = status.Summary
+			}
+			d.Set("replication_state", replicationState)
+			if err := d.Set("replication_status", flattenSharedImageVersionReplicationStatus(replicationStatus)); err != nil {
+				return fmt.Errorf("setting `replication_status`: %+v", err)
+			}
 		}
 		return tags.FlattenAndSet(d, model.Tags)
 	}
@@ -565,3 +676,128 @@ func flattenSharedImageVersionTargetRegions(input *[]galleryimageversions.Target
 
 	return results
 }
+
+const (
+	sharedImageVersionReplicationCompletionModeAllRegions    = "AllRegions"
+	sharedImageVersionReplicationCompletionModePrimaryRegion = "PrimaryRegion"
+)
+
+// createOrUpdateSharedImageVersion creates or updates the Image Version, then waits for it to be replicated according to the
+// `replication_completion_mode` - either to every target region, or only to the region the Image Version is created in
+func createOrUpdateSharedImageVersion(ctx context.Context, client *galleryimageversions.GalleryImageVersionsClient, id galleryimageversions.ImageVersionId, payload galleryimageversions.GalleryImageVersion, completionMode string) error {
+	result, err := client.CreateOrUpdate(ctx, id, payload)
+	if err != nil {
+		return err
+	}
+
+	if completionMode == sharedImageVersionReplicationCompletionModePrimaryRegion {
+		return waitForSharedImageVersionRegionReplication(ctx, client, id, payload.Location)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		// the error returned when replication fails doesn't say which regions failed, so include the status of each region
+		if failures := sharedImageVersionReplicationFailures(ctx, client, id); failures != "" {
+			return fmt.Errorf("%+v\n\n%s", err, failures)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func waitForSharedImageVersionRegionReplication(ctx context.Context, client *galleryimageversions.GalleryImageVersionsClient, id galleryimageversions.ImageVersionId, region string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	log.Printf("[DEBUG] Waiting for %s to be replicated to %q", id, region)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(galleryimageversions.ReplicationStateReplicating), string(galleryimageversions.ReplicationStateUnknown)},
+		Target:     []string{string(galleryimageversions.ReplicationStateCompleted)},
+		Refresh:    sharedImageVersionRegionReplicationRefreshFunc(ctx, client, id, region),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for replication to %q: %+v", region, err)
+	}
+
+	return nil
+}
+
+func sharedImageVersionRegionReplicationRefreshFunc(ctx context.Context, client *galleryimageversions.GalleryImageVersionsClient, id galleryimageversions.ImageVersionId, region string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id, galleryimageversions.GetOperationOptions{
+			Expand: pointer.To(galleryimageversions.ReplicationStatusTypesReplicationStatus),
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil {
+			return resp, string(galleryimageversions.ReplicationStateUnknown), nil
+		}
+		props := resp.Model.Properties
+
+		if pointer.From(props.ProvisioningState) == galleryimageversions.GalleryProvisioningStateFailed {
+			return nil, "", fmt.Errorf("provisioning failed")
+		}
+
+		if props.ReplicationStatus != nil && props.ReplicationStatus.Summary != nil {
+			for _, v := range *props.ReplicationStatus.Summary {
+				if location.Normalize(pointer.From(v.Region)) != location.Normalize(region) {
+					continue
+				}
+
+				state := pointer.From(v.State)
+				if state == galleryimageversions.ReplicationStateFailed {
+					return nil, "", fmt.Errorf("replication failed: %s", pointer.From(v.Details))
+				}
+
+				log.Printf("[DEBUG] %s is %d%% replicated to %q", id, pointer.From(v.Progress), region)
+				return resp, string(state), nil
+			}
+		}
+
+		return resp, string(galleryimageversions.ReplicationStateUnknown), nil
+	}
+}
+
+// sharedImageVersionReplicationFailures returns a description of each region the Image Version failed to replicate to
+func sharedImageVersionReplicationFailures(ctx context.Context, client *galleryimageversions.GalleryImageVersionsClient, id galleryimageversions.ImageVersionId) string {
+	resp, err := client.Get(ctx, id, galleryimageversions.GetOperationOptions{
+		Expand: pointer.To(galleryimageversions.ReplicationStatusTypesReplicationStatus),
+	})
+	if err != nil || resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.ReplicationStatus == nil || resp.Model.Properties.ReplicationStatus.Summary == nil {
+		return ""
+	}
+
+	failures := make([]string, 0)
+	for _, v := range *resp.Model.Properties.ReplicationStatus.Summary {
+		if pointer.From(v.State) == galleryimageversions.ReplicationStateFailed {
+			failures = append(failures, fmt.Sprintf("replication to %q failed: %s", location.Normalize(pointer.From(v.Region)), pointer.From(v.Details)))
+		}
+	}
+
+	return strings.Join(failures, "\n")
+}
+
+func flattenSharedImageVersionReplicationStatus(input *[]galleryimageversions.RegionalReplicationStatus) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		results = append(results, map[string]interface{}{
+			"region":   location.Normalize(pointer.From(v.Region)),
+			"state":    string(pointer.From(v.State)),
+			"progress": int(pointer.From(v.Progress)),
+			"details":  pointer.From(v.Details),
+		})
+	}
+
+	return results
+}
@@ -6,6 +6,7 @@ package compute_test
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 	"time"
 
@@ -285,6 +286,78 @@ func TestAccSharedImageVersion_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccSharedImageVersion_sourceImageVersion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_shared_image_version", "target")
+	r := SharedImageVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// need to create a vm and then reference it in the image creation
+			Config: r.setup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(ImageResource{}.virtualMachineExists, "azurerm_virtual_machine.testsource"),
+				data.CheckWithClientForResource(ImageResource{}.generalizeVirtualMachine(data), "azurerm_virtual_machine.testsource"),
+			),
+		},
+		{
+			Config: r.sourceImageVersion(data, "AllRegions"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_image_version_id").Exists(),
+				check.That(data.ResourceName).Key("replication_state").HasValue("Completed"),
+				check.That(data.ResourceName).Key("replication_status.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSharedImageVersion_replicationCompletionModePrimaryRegion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_shared_image_version", "target")
+	r := SharedImageVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// need to create a vm and then reference it in the image creation
+			Config: r.setup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(ImageResource{}.virtualMachineExists, "azurerm_virtual_machine.testsource"),
+				data.CheckWithClientForResource(ImageResource{}.generalizeVirtualMachine(data), "azurerm_virtual_machine.testsource"),
+			),
+		},
+		{
+			Config: r.sourceImageVersion(data, "PrimaryRegion"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("replication_status.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("replication_completion_mode", "replication_state", "replication_status"),
+	})
+}
+
+func TestAccSharedImageVersion_crossTenantSource(t *testing.T) {
+	sourceImageVersionId := os.Getenv("ARM_TEST_CROSS_TENANT_IMAGE_VERSION_ID")
+	tenantId := os.Getenv("ARM_TENANT_ID_ALT")
+	if sourceImageVersionId == "" || tenantId == "" {
+		t.Skip("Skipping as ARM_TEST_CROSS_TENANT_IMAGE_VERSION_ID and/or ARM_TENANT_ID_ALT are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_shared_image_version", "test")
+	r := SharedImageVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.crossTenantSource(data, sourceImageVersionId, tenantId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_image_version_id").HasValue(sourceImageVersionId),
+			),
+		},
+		data.ImportStep("auxiliary_tenant_ids"),
+	})
+}
+
 func (r SharedImageVersionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := galleryimageversions.ParseImageVersionID(state.ID)
 	if err != nil {
@@ -742,3 +815,98 @@ resource "azurerm_shared_image_version" "test" {
 }
 `, template)
 }
+
+func (r SharedImageVersionResource) sourceImageVersion(data acceptance.TestData, completionMode string) string {
+	template := r.imageVersion(data)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_shared_image_gallery" "target" {
+  name                = "acctestsigtarget%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_shared_image" "target" {
+  name                = "acctestimgtarget%[2]d"
+  gallery_name        = azurerm_shared_image_gallery.target.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  os_type             = "Linux"
+
+  identifier {
+    publisher = "AccTesPublisher%[2]d"
+    offer     = "AccTesOfferTarget%[2]d"
+    sku       = "AccTesSku%[2]d"
+  }
+}
+
+resource "azurerm_shared_image_version" "target" {
+  name                        = "1.0.0"
+  gallery_name                = azurerm_shared_image_gallery.target.name
+  image_name                  = azurerm_shared_image.target.name
+  resource_group_name         = azurerm_resource_group.test.name
+  location                    = azurerm_resource_group.test.location
+  source_image_version_id     = azurerm_shared_image_version.test.id
+  replication_completion_mode = %[3]q
+
+  target_region {
+    name                   = azurerm_resource_group.test.location
+    regional_replica_count = 1
+  }
+
+  target_region {
+    name                   = %[4]q
+    regional_replica_count = 1
+  }
+}
+`, template, data.RandomInteger, completionMode, data.Locations.Secondary)
+}
+
+func (r SharedImageVersionResource) crossTenantSource(data acceptance.TestData, sourceImageVersionId string, tenantId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = %[2]q
+}
+
+resource "azurerm_shared_image_gallery" "test" {
+  name                = "acctestsig%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_shared_image" "test" {
+  name                = "acctestimg%[1]d"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  os_type             = "Linux"
+
+  identifier {
+    publisher = "AccTesPublisher%[1]d"
+    offer     = "AccTesOffer%[1]d"
+    sku       = "AccTesSku%[1]d"
+  }
+}
+
+resource "azurerm_shared_image_version" "test" {
+  name                    = "1.0.0"
+  gallery_name            = azurerm_shared_image_gallery.test.name
+  image_name              = azurerm_shared_image.test.name
+  resource_group_name     = azurerm_resource_group.test.name
+  location                = azurerm_resource_group.test.location
+  source_image_version_id = %[3]q
+  auxiliary_tenant_ids    = [%[4]q]
+
+  target_region {
+    name                   = azurerm_resource_group.test.location
+    regional_replica_count = 1
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, sourceImageVersionId, tenantId)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-03-01/virtualmachineruncommands"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
@@ -23,8 +24,9 @@ import (
 )
 
 var (
-	_ sdk.Resource           = VirtualMachineRunCommandResource{}
-	_ sdk.ResourceWithUpdate = VirtualMachineRunCommandResource{}
+	_ sdk.Resource                  = VirtualMachineRunCommandResource{}
+	_ sdk.ResourceWithUpdate        = VirtualMachineRunCommandResource{}
+	_ sdk.ResourceWithCustomizeDiff = VirtualMachineRunCommandResource{}
 )
 
 type VirtualMachineRunCommandResource struct{}
@@ -34,11 +36,14 @@ func (r VirtualMachineRunCommandResource) ModelObject() interface{} {
 }
 
 type VirtualMachineRunCommandResourceSchema struct {
+	ContentHash               string                                          `tfschema:"content_hash"`
 	ErrorBlobManagedIdentity  []VirtualMachineRunCommandManagedIdentitySchema `tfschema:"error_blob_managed_identity"`
 	ErrorBlobUri              string                                          `tfschema:"error_blob_uri"`
+	ExpectedExitCodes         []int64                                         `tfschema:"expected_exit_codes"`
 	InstanceView              []VirtualMachineRunCommandInstanceViewSchema    `tfschema:"instance_view"`
 	Location                  string                                          `tfschema:"location"`
 	Name                      string                                          `tfschema:"name"`
+	OneShotEnabled            bool                                            `tfschema:"one_shot_enabled"`
 	OutputBlobManagedIdentity []VirtualMachineRunCommandManagedIdentitySchema `tfschema:"output_blob_managed_identity"`
 	OutputBlobUri             string                                          `tfschema:"output_blob_uri"`
 	Parameter                 []VirtualMachineRunCommandInputParameterSchema  `tfschema:"parameter"`
@@ -210,6 +215,22 @@ func (r VirtualMachineRunCommandResource) Arguments() map[string]*pluginsdk.Sche
 			ValidateFunc: validation.IsURLWithHTTPS,
 		},
 
+		"expected_exit_codes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeInt,
+			},
+		},
+
+		"one_shot_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
 		"output_blob_managed_identity": {
 			Type:      pluginsdk.TypeList,
 			Optional:  true,
@@ -310,6 +331,11 @@ func (r VirtualMachineRunCommandResource) Arguments() map[string]*pluginsdk.Sche
 
 func (r VirtualMachineRunCommandResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
+		"content_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
 		"instance_view": {
 			Type:     pluginsdk.TypeList,
 			Computed: true,
@@ -349,6 +375,30 @@ func (r VirtualMachineRunCommandResource) Attributes() map[string]*pluginsdk.Sch
 	}
 }
 
+func (r VirtualMachineRunCommandResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+			if rd.Id() == "" {
+				return nil
+			}
+
+			// changing the content of the command runs it again, so the result isn't known until apply
+			if rd.HasChanges(runCommandContentFields...) {
+				if err := rd.SetNewComputed("content_hash"); err != nil {
+					return err
+				}
+				if err := rd.SetNewComputed("instance_view"); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r VirtualMachineRunCommandResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -379,27 +429,7 @@ func (r VirtualMachineRunCommandResource) Create() sdk.ResourceFunc {
 				return metadata.ResourceRequiresImport(r.ResourceType(), id)
 			}
 
-			payload := virtualmachineruncommands.VirtualMachineRunCommand{
-				Location: location.Normalize(config.Location),
-				Tags:     tags.Expand(config.Tags),
-				Properties: &virtualmachineruncommands.VirtualMachineRunCommandProperties{
-					ErrorBlobManagedIdentity:  expandVirtualMachineRunCommandBlobManagedIdentity(config.ErrorBlobManagedIdentity),
-					ErrorBlobUri:              pointer.To(config.ErrorBlobUri),
-					OutputBlobManagedIdentity: expandVirtualMachineRunCommandBlobManagedIdentity(config.OutputBlobManagedIdentity),
-					OutputBlobUri:             pointer.To(config.OutputBlobUri),
-					Parameters:                expandVirtualMachineRunCommandInputParameter(config.Parameter),
-					ProtectedParameters:       expandVirtualMachineRunCommandInputParameter(config.ProtectedParameter),
-					RunAsPassword:             pointer.To(config.RunAsPassword),
-					RunAsUser:                 pointer.To(config.RunAsUser),
-					Source:                    expandVirtualMachineRunCommandSource(config.Source),
-
-					TimeoutInSeconds: pointer.To(int64(metadata.ResourceData.Timeout(pluginsdk.TimeoutCreate).Seconds())),
-
-					// set API returning error if command run fails
-					TreatFailureAsDeploymentFailure: pointer.To(true),
-					AsyncExecution:                  pointer.To(false),
-				},
-			}
+			payload := expandVirtualMachineRunCommand(config, metadata.ResourceData.Timeout(pluginsdk.TimeoutCreate))
 
 			result, err := client.CreateOrUpdate(ctx, id, payload)
 			if err != nil {
@@ -409,11 +439,7 @@ func (r VirtualMachineRunCommandResource) Create() sdk.ResourceFunc {
 			// the resource still exists if polling fails
 			metadata.SetID(id)
 
-			if err := result.Poller.PollUntilDone(ctx); err != nil {
-				return fmt.Errorf("running the command: %+v", err)
-			}
-
-			return nil
+			return r.waitForRun(ctx, metadata, id, result.Poller, config)
 		},
 	}
 }
@@ -432,6 +458,8 @@ func (r VirtualMachineRunCommandResource) Read() sdk.ResourceFunc {
 
 			schema := VirtualMachineRunCommandResourceSchema{
 				ErrorBlobManagedIdentity:  config.ErrorBlobManagedIdentity,
+				ExpectedExitCodes:         config.ExpectedExitCodes,
+				OneShotEnabled:            config.OneShotEnabled,
 				OutputBlobManagedIdentity: config.OutputBlobManagedIdentity,
 				ProtectedParameter:        config.ProtectedParameter,
 				RunAsPassword:             config.RunAsPassword,
@@ -448,6 +476,10 @@ func (r VirtualMachineRunCommandResource) Read() sdk.ResourceFunc {
 			})
 			if err != nil {
 				if response.WasNotFound(resp.HttpResponse) {
+					// a one-shot Run Command is removed from the Virtual Machine once it has run, the result is retained in the state
+					if config.OneShotEnabled {
+						return nil
+					}
 					return metadata.MarkAsGone(*id)
 				}
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -480,6 +512,8 @@ func (r VirtualMachineRunCommandResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			schema.ContentHash = runCommandContentHash(schema.Source, schema.Parameter, schema.ProtectedParameter, schema.RunAsUser)
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -496,10 +530,19 @@ func (r VirtualMachineRunCommandResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
-			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+			result, err := client.Delete(ctx, *id)
+			if err != nil {
+				// a one-shot Run Command has already been removed from the Virtual Machine
+				if response.WasNotFound(result.HttpResponse) {
+					return nil
+				}
 				return fmt.Errorf("deleting %s: %+v", *id, err)
 			}
 
+			if err := result.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+
 			return nil
 		},
 	}
@@ -516,6 +559,26 @@ func (r VirtualMachineRunCommandResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
+			var config VirtualMachineRunCommandResourceSchema
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// a one-shot Run Command no longer exists on the Virtual Machine, so it's only run again when its content changes
+			if config.OneShotEnabled {
+				if !metadata.ResourceData.HasChanges(runCommandContentFields...) {
+					return nil
+				}
+
+				payload := expandVirtualMachineRunCommand(config, metadata.ResourceData.Timeout(pluginsdk.TimeoutUpdate))
+				result, err := client.CreateOrUpdate(ctx, *id, payload)
+				if err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+
+				return r.waitForRun(ctx, metadata, *id, result.Poller, config)
+			}
+
 			resp, err := client.GetByVirtualMachine(ctx, *id, virtualmachineruncommands.GetByVirtualMachineOperationOptions{
 				// otherwise, the response will not contain instanceView
 				Expand: pointer.To("instanceView"),
@@ -531,11 +594,6 @@ func (r VirtualMachineRunCommandResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("unexpected null properties of %s", *id)
 			}
 
-			var config VirtualMachineRunCommandResourceSchema
-			if err := metadata.Decode(&config); err != nil {
-				return fmt.Errorf("decoding: %+v", err)
-			}
-
 			if metadata.ResourceData.HasChange("error_blob_managed_identity") {
 				payload.Properties.ErrorBlobManagedIdentity = expandVirtualMachineRunCommandBlobManagedIdentity(config.ErrorBlobManagedIdentity)
 			}
@@ -544,6 +602,10 @@ func (r VirtualMachineRunCommandResource) Update() sdk.ResourceFunc {
 				payload.Properties.ErrorBlobUri = pointer.To(config.ErrorBlobUri)
 			}
 
+			if metadata.ResourceData.HasChange("expected_exit_codes") {
+				payload.Properties.TreatFailureAsDeploymentFailure = pointer.To(len(config.ExpectedExitCodes) == 0)
+			}
+
 			if metadata.ResourceData.HasChange("output_blob_managed_identity") {
 				payload.Properties.OutputBlobManagedIdentity = expandVirtualMachineRunCommandBlobManagedIdentity(config.OutputBlobManagedIdentity)
 			}
@@ -576,11 +638,95 @@ func (r VirtualMachineRunCommandResource) Update() sdk.ResourceFunc {
 				payload.Tags = tags.Expand(config.Tags)
 			}
 
-			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+			result, err := client.CreateOrUpdate(ctx, *id, *payload)
+			if err != nil {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
-			return nil
+			return r.waitForRun(ctx, metadata, *id, result.Poller, config)
+		},
+	}
+}
+
+// waitForRun waits for the command to finish running, then checks the exit code against `expected_exit_codes`. When
+// `one_shot_enabled` is set the result is written to the state and the Run Command is removed from the Virtual Machine.
+func (r VirtualMachineRunCommandResource) waitForRun(ctx context.Context, metadata sdk.ResourceMetaData, id virtualmachineruncommands.VirtualMachineRunCommandId, poller pollers.Poller, config VirtualMachineRunCommandResourceSchema) error {
+	client := metadata.Client.Compute.VirtualMachineRunCommandsClient
+
+	options := virtualmachineruncommands.GetByVirtualMachineOperationOptions{
+		Expand: pointer.To("instanceView"),
+	}
+
+	getOutput := func(ctx context.Context) (string, error) {
+		resp, err := client.GetByVirtualMachine(ctx, id, options)
+		if err != nil {
+			return "", err
+		}
+		if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.InstanceView == nil {
+			return "", nil
+		}
+		return pointer.From(resp.Model.Properties.InstanceView.Output), nil
+	}
+
+	if err := pollRunCommandUntilDone(ctx, poller, id.String(), getOutput); err != nil {
+		return fmt.Errorf("running the command: %+v", err)
+	}
+
+	resp, err := client.GetByVirtualMachine(ctx, id, options)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	var instanceView *virtualmachineruncommands.VirtualMachineRunCommandInstanceView
+	if resp.Model != nil && resp.Model.Properties != nil {
+		instanceView = resp.Model.Properties.InstanceView
+	}
+
+	if instanceView != nil {
+		if err := checkRunCommandExitCode(instanceView.ExitCode, config.ExpectedExitCodes, pointer.From(instanceView.Output), pointer.From(instanceView.Error)); err != nil {
+			return fmt.Errorf("running %s: %+v", id, err)
+		}
+	} else if len(config.ExpectedExitCodes) > 0 {
+		return fmt.Errorf("running %s: the instance view was not returned so the exit code could not be checked", id)
+	}
+
+	if !config.OneShotEnabled {
+		return nil
+	}
+
+	config.InstanceView = flattenVirtualMachineRunCommandInstanceView(instanceView)
+	config.ContentHash = runCommandContentHash(config.Source, config.Parameter, config.ProtectedParameter, config.RunAsUser)
+	if err := metadata.Encode(&config); err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("removing one-shot %s after it has run: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandVirtualMachineRunCommand(config VirtualMachineRunCommandResourceSchema, timeout time.Duration) virtualmachineruncommands.VirtualMachineRunCommand {
+	return virtualmachineruncommands.VirtualMachineRunCommand{
+		Location: location.Normalize(config.Location),
+		Tags:     tags.Expand(config.Tags),
+		Properties: &virtualmachineruncommands.VirtualMachineRunCommandProperties{
+			ErrorBlobManagedIdentity:  expandVirtualMachineRunCommandBlobManagedIdentity(config.ErrorBlobManagedIdentity),
+			ErrorBlobUri:              pointer.To(config.ErrorBlobUri),
+			OutputBlobManagedIdentity: expandVirtualMachineRunCommandBlobManagedIdentity(config.OutputBlobManagedIdentity),
+			OutputBlobUri:             pointer.To(config.OutputBlobUri),
+			Parameters:                expandVirtualMachineRunCommandInputParameter(config.Parameter),
+			ProtectedParameters:       expandVirtualMachineRunCommandInputParameter(config.ProtectedParameter),
+			RunAsPassword:             pointer.To(config.RunAsPassword),
+			RunAsUser:                 pointer.To(config.RunAsUser),
+			Source:                    expandVirtualMachineRunCommandSource(config.Source),
+
+			TimeoutInSeconds: pointer.To(int64(timeout.Seconds())),
+
+			// set API returning error if command run fails, unless the exit code is checked against `expected_exit_codes`
+			TreatFailureAsDeploymentFailure: pointer.To(len(config.ExpectedExitCodes) == 0),
+			AsyncExecution:                  pointer.To(false),
 		},
 	}
 }
@@ -179,6 +179,49 @@ func TestAccVirtualMachineRunCommand_update(t *testing.T) {
 	})
 }
 
+func TestAccVirtualMachineRunCommand_expectedExitCodes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.expectedExitCodes(data, 1),
+			ExpectError: regexp.MustCompile("the command exited with code 1"),
+		},
+		{
+			Config: r.expectedExitCodes(data, 3),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.exit_code").HasValue("3"),
+			),
+		},
+		data.ImportStep("expected_exit_codes"),
+	})
+}
+
+func TestAccVirtualMachineRunCommand_oneShot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandTestResource{}
+
+	// a one-shot Run Command is removed from the Virtual Machine once it has run, so it can't be imported or checked for
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.oneShot(data, "hello"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("instance_view.0.exit_code").HasValue("0"),
+				check.That(data.ResourceName).Key("content_hash").IsNotEmpty(),
+			),
+		},
+		{
+			Config: r.oneShot(data, "goodbye"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("instance_view.0.exit_code").HasValue("0"),
+				check.That(data.ResourceName).Key("content_hash").IsNotEmpty(),
+			),
+		},
+	})
+}
+
 func (r VirtualMachineRunCommandTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := virtualmachineruncommands.ParseVirtualMachineRunCommandID(state.ID)
 	if err != nil {
@@ -594,6 +637,46 @@ resource "azurerm_virtual_machine_run_command" "test" {
 `, r.template(data))
 }
 
+func (r VirtualMachineRunCommandTestResource) expectedExitCodes(data acceptance.TestData, exitCode int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name                = "acctestvmrc-${var.random_string}"
+  location            = azurerm_resource_group.test.location
+  virtual_machine_id  = azurerm_linux_virtual_machine.test.id
+  expected_exit_codes = [0, %d]
+  source {
+    script = "echo 'hello world' && exit 3"
+  }
+}
+`, r.template(data), exitCode)
+}
+
+func (r VirtualMachineRunCommandTestResource) oneShot(data acceptance.TestData, message string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestvmrc-${var.random_string}"
+  location           = azurerm_resource_group.test.location
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+  one_shot_enabled   = true
+  source {
+    script = "echo '%s'"
+  }
+}
+`, r.template(data), message)
+}
+
 func (r VirtualMachineRunCommandTestResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 variable "primary_location" {
@@ -0,0 +1,914 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-03-01/virtualmachinescalesetvmruncommands"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetvms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// virtualMachineScaleSetRunCommandParallelism is the number of instances the command is run on at the same time
+const virtualMachineScaleSetRunCommandParallelism = 10
+
+var (
+	_ sdk.Resource                  = VirtualMachineScaleSetRunCommandResource{}
+	_ sdk.ResourceWithUpdate        = VirtualMachineScaleSetRunCommandResource{}
+	_ sdk.ResourceWithCustomizeDiff = VirtualMachineScaleSetRunCommandResource{}
+)
+
+type VirtualMachineScaleSetRunCommandResource struct{}
+
+func (r VirtualMachineScaleSetRunCommandResource) ModelObject() interface{} {
+	return &VirtualMachineScaleSetRunCommandResourceSchema{}
+}
+
+type VirtualMachineScaleSetRunCommandResourceSchema struct {
+	ContentHash              string                                           `tfschema:"content_hash"`
+	ExpectedExitCodes        []int64                                          `tfschema:"expected_exit_codes"`
+	FailedInstanceCount      int64                                            `tfschema:"failed_instance_count"`
+	InstanceIds              []string                                         `tfschema:"instance_ids"`
+	InstanceResult           []VirtualMachineScaleSetRunCommandInstanceResult `tfschema:"instance_result"`
+	Location                 string                                           `tfschema:"location"`
+	Name                     string                                           `tfschema:"name"`
+	Parameter                []VirtualMachineRunCommandInputParameterSchema   `tfschema:"parameter"`
+	ProtectedParameter       []VirtualMachineRunCommandInputParameterSchema   `tfschema:"protected_parameter"`
+	RunAsPassword            string                                           `tfschema:"run_as_password"`
+	RunAsUser                string                                           `tfschema:"run_as_user"`
+	Source                   []VirtualMachineRunCommandScriptSourceSchema     `tfschema:"source"`
+	SucceededInstanceCount   int64                                            `tfschema:"succeeded_instance_count"`
+	Tags                     map[string]interface{}                           `tfschema:"tags"`
+	VirtualMachineScaleSetId string                                           `tfschema:"virtual_machine_scale_set_id"`
+}
+
+type VirtualMachineScaleSetRunCommandInstanceResult struct {
+	InstanceId     string `tfschema:"instance_id"`
+	ExitCode       int64  `tfschema:"exit_code"`
+	ExecutionState string `tfschema:"execution_state"`
+	Output         string `tfschema:"output"`
+	ErrorMessage   string `tfschema:"error_message"`
+	StartTime      string `tfschema:"start_time"`
+	EndTime        string `tfschema:"end_time"`
+	Succeeded      bool   `tfschema:"succeeded"`
+}
+
+func (r VirtualMachineScaleSetRunCommandResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.VirtualMachineScaleSetRunCommandID
+}
+
+func (r VirtualMachineScaleSetRunCommandResource) ResourceType() string {
+	return "azurerm_virtual_machine_scale_set_run_command"
+}
+
+func (r VirtualMachineScaleSetRunCommandResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.VirtualMachineRunCommandName,
+		},
+
+		"virtual_machine_scale_set_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateVirtualMachineScaleSetID,
+		},
+
+		"location": commonschema.Location(),
+
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"command_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{
+							"source.0.command_id",
+							"source.0.script",
+							"source.0.script_uri",
+						},
+					},
+					"script": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{
+							"source.0.command_id",
+							"source.0.script",
+							"source.0.script_uri",
+						},
+					},
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+						ExactlyOneOf: []string{
+							"source.0.command_id",
+							"source.0.script",
+							"source.0.script_uri",
+						},
+					},
+					"script_uri_managed_identity": {
+						Type:      pluginsdk.TypeList,
+						Optional:  true,
+						Sensitive: true,
+						MaxItems:  1,
+						RequiredWith: []string{
+							"source.0.script_uri",
+						},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"client_id": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Sensitive:    true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									ConflictsWith: []string{
+										"source.0.script_uri_managed_identity.0.object_id",
+									},
+								},
+								"object_id": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Sensitive:    true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									ConflictsWith: []string{
+										"source.0.script_uri_managed_identity.0.client_id",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"expected_exit_codes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeInt,
+			},
+		},
+
+		"instance_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"parameter": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"protected_parameter": {
+			Type:      pluginsdk.TypeList,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"run_as_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"run_as_user": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r VirtualMachineScaleSetRunCommandResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"content_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"failed_instance_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"instance_result": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"instance_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"exit_code": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+					"execution_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"output": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"error_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"succeeded": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"succeeded_instance_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetRunCommandResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+			if rd.Id() == "" {
+				return nil
+			}
+
+			if rd.HasChanges(runCommandContentFields...) {
+				if err := rd.SetNewComputed("content_hash"); err != nil {
+					return err
+				}
+			}
+
+			// the instances the command is run on, or the command itself, changing means the results aren't known until apply
+			if rd.HasChanges(append([]string{"instance_ids", "expected_exit_codes"}, runCommandContentFields...)...) {
+				for _, k := range []string{"instance_result", "succeeded_instance_count", "failed_instance_count"} {
+					if err := rd.SetNewComputed(k); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetRunCommandResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineScaleSetVMRunCommandsClient
+
+			var config VirtualMachineScaleSetRunCommandResourceSchema
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scaleSetId, err := commonids.ParseVirtualMachineScaleSetID(config.VirtualMachineScaleSetId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewVirtualMachineScaleSetRunCommandID(scaleSetId.SubscriptionId, scaleSetId.ResourceGroupName, scaleSetId.VirtualMachineScaleSetName, config.Name)
+
+			instanceIds, err := r.targetInstanceIds(ctx, metadata, *scaleSetId, config.InstanceIds)
+			if err != nil {
+				return err
+			}
+
+			for _, instanceId := range instanceIds {
+				runCommandId := virtualmachinescalesetvmruncommands.NewVirtualMachineScaleSetVirtualMachineRunCommandID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, instanceId, id.RunCommandName)
+				existing, err := client.Get(ctx, runCommandId, virtualmachinescalesetvmruncommands.DefaultGetOperationOptions())
+				if err != nil {
+					if !response.WasNotFound(existing.HttpResponse) {
+						return fmt.Errorf("checking for the presence of an existing %s: %+v", runCommandId, err)
+					}
+				}
+				if !response.WasNotFound(existing.HttpResponse) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			payload := expandVirtualMachineScaleSetRunCommand(config, metadata.ResourceData.Timeout(pluginsdk.TimeoutCreate))
+
+			// the Run Command exists on any instance it's been started on, so the ID is set before waiting for the results
+			metadata.SetID(id)
+
+			return r.runOnInstances(ctx, metadata, id, instanceIds, payload, config)
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetRunCommandResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineScaleSetVMRunCommandsClient
+
+			id, err := parse.VirtualMachineScaleSetRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// ProtectedParameter, RunAsPassword and Source.ScriptUriManagedIdentity are regarded as sensitive and not returned by API
+			var config VirtualMachineScaleSetRunCommandResourceSchema
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scaleSetId := commonids.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+
+			// the instances the command was last run on are tracked in `instance_result`, when importing every instance is checked
+			instanceIds := make([]string, 0)
+			for _, v := range config.InstanceResult {
+				instanceIds = append(instanceIds, v.InstanceId)
+			}
+			if len(instanceIds) == 0 {
+				if instanceIds, err = r.listInstanceIds(ctx, metadata, scaleSetId); err != nil {
+					return err
+				}
+			}
+
+			schema := VirtualMachineScaleSetRunCommandResourceSchema{
+				ExpectedExitCodes:        config.ExpectedExitCodes,
+				InstanceIds:              config.InstanceIds,
+				Name:                     id.RunCommandName,
+				ProtectedParameter:       config.ProtectedParameter,
+				RunAsPassword:            config.RunAsPassword,
+				VirtualMachineScaleSetId: scaleSetId.ID(),
+			}
+
+			found := false
+			for _, instanceId := range instanceIds {
+				runCommandId := virtualmachinescalesetvmruncommands.NewVirtualMachineScaleSetVirtualMachineRunCommandID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, instanceId, id.RunCommandName)
+				resp, err := client.Get(ctx, runCommandId, virtualmachinescalesetvmruncommands.GetOperationOptions{
+					// otherwise, the response will not contain instanceView
+					Expand: pointer.To("instanceView"),
+				})
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						// the instance may have been removed by scaling in
+						continue
+					}
+					return fmt.Errorf("retrieving %s: %+v", runCommandId, err)
+				}
+
+				model := resp.Model
+				if model == nil || model.Properties == nil {
+					continue
+				}
+
+				if !found {
+					found = true
+					schema.Location = location.Normalize(model.Location)
+					schema.Tags = tags.Flatten(model.Tags)
+					schema.Parameter = flattenVirtualMachineScaleSetRunCommandInputParameter(model.Properties.Parameters)
+					schema.RunAsUser = pointer.From(model.Properties.RunAsUser)
+					schema.Source = flattenVirtualMachineScaleSetRunCommandSource(model.Properties.Source, config.Source)
+				}
+
+				schema.InstanceResult = append(schema.InstanceResult, flattenVirtualMachineScaleSetRunCommandInstanceResult(instanceId, model.Properties.InstanceView, config.ExpectedExitCodes))
+			}
+
+			if !found {
+				return metadata.MarkAsGone(id)
+			}
+
+			for _, v := range schema.InstanceResult {
+				if v.Succeeded {
+					schema.SucceededInstanceCount++
+				} else {
+					schema.FailedInstanceCount++
+				}
+			}
+
+			schema.ContentHash = runCommandContentHash(schema.Source, schema.Parameter, schema.ProtectedParameter, schema.RunAsUser)
+
+			return metadata.Encode(&schema)
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetRunCommandResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineScaleSetVMRunCommandsClient
+
+			id, err := parse.VirtualMachineScaleSetRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config VirtualMachineScaleSetRunCommandResourceSchema
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scaleSetId := commonids.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+
+			instanceIds, err := r.targetInstanceIds(ctx, metadata, scaleSetId, config.InstanceIds)
+			if err != nil {
+				return err
+			}
+
+			// `instance_result` is marked as computed in the plan whenever the targeted instances change, so the
+			// instances the command was previously run on have to come from the prior state
+			old, _ := metadata.ResourceData.GetChange("instance_result")
+			previousInstanceIds := runCommandPreviousInstanceIds(old)
+
+			// remove the command from any instances which are no longer targeted
+			for _, instanceId := range runCommandUntargetedInstanceIds(previousInstanceIds, instanceIds) {
+				runCommandId := virtualmachinescalesetvmruncommands.NewVirtualMachineScaleSetVirtualMachineRunCommandID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, instanceId, id.RunCommandName)
+				if err := deleteVirtualMachineScaleSetRunCommand(ctx, client, runCommandId); err != nil {
+					return err
+				}
+			}
+
+			// the command is only run again on existing instances when what it runs has changed
+			runInstanceIds := instanceIds
+			if !metadata.ResourceData.HasChanges(append([]string{"run_as_password", "expected_exit_codes"}, runCommandContentFields...)...) {
+				runInstanceIds = make([]string, 0)
+				for _, instanceId := range instanceIds {
+					if !slices.Contains(previousInstanceIds, instanceId) {
+						runInstanceIds = append(runInstanceIds, instanceId)
+					}
+				}
+
+				if metadata.ResourceData.HasChange("tags") {
+					for _, instanceId := range instanceIds {
+						if !slices.Contains(previousInstanceIds, instanceId) {
+							continue
+						}
+
+						runCommandId := virtualmachinescalesetvmruncommands.NewVirtualMachineScaleSetVirtualMachineRunCommandID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, instanceId, id.RunCommandName)
+						payload := virtualmachinescalesetvmruncommands.VirtualMachineRunCommandUpdate{
+							Tags: tags.Expand(config.Tags),
+						}
+						if err := client.UpdateThenPoll(ctx, runCommandId, payload); err != nil {
+							return fmt.Errorf("updating `tags` for %s: %+v", runCommandId, err)
+						}
+					}
+				}
+			}
+
+			payload := expandVirtualMachineScaleSetRunCommand(config, metadata.ResourceData.Timeout(pluginsdk.TimeoutUpdate))
+
+			return r.runOnInstances(ctx, metadata, *id, runInstanceIds, payload, config)
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetRunCommandResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineScaleSetVMRunCommandsClient
+
+			id, err := parse.VirtualMachineScaleSetRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config VirtualMachineScaleSetRunCommandResourceSchema
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, v := range config.InstanceResult {
+				runCommandId := virtualmachinescalesetvmruncommands.NewVirtualMachineScaleSetVirtualMachineRunCommandID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, v.InstanceId, id.RunCommandName)
+				if err := deleteVirtualMachineScaleSetRunCommand(ctx, client, runCommandId); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// targetInstanceIds returns the instances specified in `instance_ids`, or every instance in the Virtual Machine Scale Set when it's not set
+func (r VirtualMachineScaleSetRunCommandResource) targetInstanceIds(ctx context.Context, metadata sdk.ResourceMetaData, scaleSetId commonids.VirtualMachineScaleSetId, instanceIds []string) ([]string, error) {
+	if len(instanceIds) > 0 {
+		return instanceIds, nil
+	}
+
+	instanceIds, err := r.listInstanceIds(ctx, metadata, scaleSetId)
+	if err != nil {
+		return nil, err
+	}
+	if len(instanceIds) == 0 {
+		return nil, fmt.Errorf("no instances were found in %s to run the command on", scaleSetId)
+	}
+
+	return instanceIds, nil
+}
+
+func (r VirtualMachineScaleSetRunCommandResource) listInstanceIds(ctx context.Context, metadata sdk.ResourceMetaData, scaleSetId commonids.VirtualMachineScaleSetId) ([]string, error) {
+	client := metadata.Client.Compute.VirtualMachineScaleSetVMsClient
+
+	id := virtualmachinescalesetvms.NewVirtualMachineScaleSetID(scaleSetId.SubscriptionId, scaleSetId.ResourceGroupName, scaleSetId.VirtualMachineScaleSetName)
+	result, err := client.ListComplete(ctx, id, virtualmachinescalesetvms.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing instances for %s: %+v", scaleSetId, err)
+	}
+
+	instanceIds := make([]string, 0)
+	for _, item := range result.Items {
+		if item.InstanceId != nil {
+			instanceIds = append(instanceIds, *item.InstanceId)
+		}
+	}
+
+	return instanceIds, nil
+}
+
+// runOnInstances runs the command on each of the instances, a limited number at a time, then records the result for every
+// instance in the state. An error is returned listing each instance where the command couldn't be run or exited with an
+// unexpected exit code.
+func (r VirtualMachineScaleSetRunCommandResource) runOnInstances(ctx context.Context, metadata sdk.ResourceMetaData, id parse.VirtualMachineScaleSetRunCommandId, instanceIds []string, payload virtualmachinescalesetvmruncommands.VirtualMachineRunCommand, config VirtualMachineScaleSetRunCommandResourceSchema) error {
+	client := metadata.Client.Compute.VirtualMachineScaleSetVMRunCommandsClient
+
+	type instanceRun struct {
+		instanceView *virtualmachinescalesetvmruncommands.VirtualMachineRunCommandInstanceView
+		err          error
+	}
+
+	runs := make([]instanceRun, len(instanceIds))
+	limit := make(chan struct{}, virtualMachineScaleSetRunCommandParallelism)
+	wg := &sync.WaitGroup{}
+	wg.Add(len(instanceIds))
+
+	for i, instanceId := range instanceIds {
+		go func(i int, instanceId string) {
+			defer wg.Done()
+			limit <- struct{}{}
+			defer func() { <-limit }()
+
+			runCommandId := virtualmachinescalesetvmruncommands.NewVirtualMachineScaleSetVirtualMachineRunCommandID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, instanceId, id.RunCommandName)
+			runs[i].instanceView, runs[i].err = runVirtualMachineScaleSetRunCommand(ctx, client, runCommandId, payload)
+		}(i, instanceId)
+	}
+	wg.Wait()
+
+	failures := make([]string, 0)
+	results := make(map[string]VirtualMachineScaleSetRunCommandInstanceResult)
+	for _, v := range config.InstanceResult {
+		results[v.InstanceId] = v
+	}
+
+	for i, instanceId := range instanceIds {
+		run := runs[i]
+		if run.err != nil {
+			failures = append(failures, fmt.Sprintf("instance %q: %+v", instanceId, run.err))
+			results[instanceId] = VirtualMachineScaleSetRunCommandInstanceResult{
+				InstanceId:   instanceId,
+				ErrorMessage: run.err.Error(),
+			}
+			continue
+		}
+
+		result := flattenVirtualMachineScaleSetRunCommandInstanceResult(instanceId, run.instanceView, config.ExpectedExitCodes)
+		results[instanceId] = result
+		if !result.Succeeded {
+			var exitCode *int64
+			if run.instanceView != nil {
+				exitCode = run.instanceView.ExitCode
+			}
+			err := checkRunCommandExitCode(exitCode, virtualMachineScaleSetRunCommandExpectedExitCodes(config.ExpectedExitCodes), result.Output, result.ErrorMessage)
+			if err == nil {
+				err = fmt.Errorf("the command finished in the state %q", result.ExecutionState)
+			}
+			failures = append(failures, fmt.Sprintf("instance %q: %+v", instanceId, err))
+		}
+	}
+
+	config.InstanceResult = make([]VirtualMachineScaleSetRunCommandInstanceResult, 0)
+	config.SucceededInstanceCount = 0
+	config.FailedInstanceCount = 0
+	for _, v := range results {
+		config.InstanceResult = append(config.InstanceResult, v)
+		if v.Succeeded {
+			config.SucceededInstanceCount++
+		} else {
+			config.FailedInstanceCount++
+		}
+	}
+	sort.Slice(config.InstanceResult, func(i, j int) bool {
+		return config.InstanceResult[i].InstanceId < config.InstanceResult[j].InstanceId
+	})
+	config.ContentHash = runCommandContentHash(config.Source, config.Parameter, config.ProtectedParameter, config.RunAsUser)
+
+	if err := metadata.Encode(&config); err != nil {
+		return err
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("running %s failed on %d of %d instances:\n\n%s", id, len(failures), len(instanceIds), strings.Join(failures, "\n\n"))
+	}
+
+	return nil
+}
+
+func runVirtualMachineScaleSetRunCommand(ctx context.Context, client *virtualmachinescalesetvmruncommands.VirtualMachineScaleSetVMRunCommandsClient, id virtualmachinescalesetvmruncommands.VirtualMachineScaleSetVirtualMachineRunCommandId, payload virtualmachinescalesetvmruncommands.VirtualMachineRunCommand) (*virtualmachinescalesetvmruncommands.VirtualMachineRunCommandInstanceView, error) {
+	options := virtualmachinescalesetvmruncommands.GetOperationOptions{
+		Expand: pointer.To("instanceView"),
+	}
+
+	result, err := client.CreateOrUpdate(ctx, id, payload)
+	if err != nil {
+		return nil, fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	getOutput := func(ctx context.Context) (string, error) {
+		resp, err := client.Get(ctx, id, options)
+		if err != nil {
+			return "", err
+		}
+		if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.InstanceView == nil {
+			return "", nil
+		}
+		return pointer.From(resp.Model.Properties.InstanceView.Output), nil
+	}
+
+	if err := pollRunCommandUntilDone(ctx, result.Poller, id.String(), getOutput); err != nil {
+		return nil, fmt.Errorf("running the command: %+v", err)
+	}
+
+	resp, err := client.Get(ctx, id, options)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return nil, nil
+	}
+
+	return resp.Model.Properties.InstanceView, nil
+}
+
+func deleteVirtualMachineScaleSetRunCommand(ctx context.Context, client *virtualmachinescalesetvmruncommands.VirtualMachineScaleSetVMRunCommandsClient, id virtualmachinescalesetvmruncommands.VirtualMachineScaleSetVirtualMachineRunCommandId) error {
+	result, err := client.Delete(ctx, id)
+	if err != nil {
+		// the instance may have already been removed by scaling in
+		if response.WasNotFound(result.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// virtualMachineScaleSetRunCommandExpectedExitCodes returns the exit codes which are treated as success, any exit code
+// other than `0` is a failure unless `expected_exit_codes` is specified
+func virtualMachineScaleSetRunCommandExpectedExitCodes(input []int64) []int64 {
+	if len(input) == 0 {
+		return []int64{0}
+	}
+	return input
+}
+
+func expandVirtualMachineScaleSetRunCommand(config VirtualMachineScaleSetRunCommandResourceSchema, timeout time.Duration) virtualmachinescalesetvmruncommands.VirtualMachineRunCommand {
+	return virtualmachinescalesetvmruncommands.VirtualMachineRunCommand{
+		Location: location.Normalize(config.Location),
+		Tags:     tags.Expand(config.Tags),
+		Properties: &virtualmachinescalesetvmruncommands.VirtualMachineRunCommandProperties{
+			Parameters:          expandVirtualMachineScaleSetRunCommandInputParameter(config.Parameter),
+			ProtectedParameters: expandVirtualMachineScaleSetRunCommandInputParameter(config.ProtectedParameter),
+			RunAsPassword:       pointer.To(config.RunAsPassword),
+			RunAsUser:           pointer.To(config.RunAsUser),
+			Source:              expandVirtualMachineScaleSetRunCommandSource(config.Source),
+
+			TimeoutInSeconds: pointer.To(int64(timeout.Seconds())),
+
+			// the exit code of every instance is checked once they've all finished, so that the results can be aggregated
+			TreatFailureAsDeploymentFailure: pointer.To(false),
+			AsyncExecution:                  pointer.To(false),
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetRunCommandInputParameter(input []VirtualMachineRunCommandInputParameterSchema) *[]virtualmachinescalesetvmruncommands.RunCommandInputParameter {
+	output := make([]virtualmachinescalesetvmruncommands.RunCommandInputParameter, 0)
+
+	for _, v := range input {
+		output = append(output, virtualmachinescalesetvmruncommands.RunCommandInputParameter{
+			Name:  v.Name,
+			Value: v.Value,
+		})
+	}
+
+	return &output
+}
+
+func flattenVirtualMachineScaleSetRunCommandInputParameter(input *[]virtualmachinescalesetvmruncommands.RunCommandInputParameter) []VirtualMachineRunCommandInputParameterSchema {
+	output := make([]VirtualMachineRunCommandInputParameterSchema, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, VirtualMachineRunCommandInputParameterSchema{
+			Name:  v.Name,
+			Value: v.Value,
+		})
+	}
+
+	return output
+}
+
+func expandVirtualMachineScaleSetRunCommandSource(input []VirtualMachineRunCommandScriptSourceSchema) *virtualmachinescalesetvmruncommands.VirtualMachineRunCommandScriptSource {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := &virtualmachinescalesetvmruncommands.VirtualMachineRunCommandScriptSource{}
+
+	if input[0].CommandId != "" {
+		output.CommandId = pointer.To(input[0].CommandId)
+	}
+	if input[0].Script != "" {
+		output.Script = pointer.To(input[0].Script)
+	}
+	if input[0].ScriptUri != "" {
+		output.ScriptUri = pointer.To(input[0].ScriptUri)
+	}
+	if identity := input[0].ScriptUriManagedIdentity; len(identity) > 0 {
+		output.ScriptUriManagedIdentity = &virtualmachinescalesetvmruncommands.RunCommandManagedIdentity{}
+		if identity[0].ClientId != "" {
+			output.ScriptUriManagedIdentity.ClientId = pointer.To(identity[0].ClientId)
+		}
+		if identity[0].ObjectId != "" {
+			output.ScriptUriManagedIdentity.ObjectId = pointer.To(identity[0].ObjectId)
+		}
+	}
+
+	return output
+}
+
+func flattenVirtualMachineScaleSetRunCommandSource(input *virtualmachinescalesetvmruncommands.VirtualMachineRunCommandScriptSource, config []VirtualMachineRunCommandScriptSourceSchema) []VirtualMachineRunCommandScriptSourceSchema {
+	if input == nil {
+		return []VirtualMachineRunCommandScriptSourceSchema{}
+	}
+
+	// if scriptUri is SAS URL, it will not be returned by API
+	scriptUri := pointer.From(input.ScriptUri)
+	var scriptUriManagedIdentity []VirtualMachineRunCommandManagedIdentitySchema
+	if len(config) > 0 {
+		if strings.Contains(config[0].ScriptUri, "sig=") {
+			scriptUri = config[0].ScriptUri
+		}
+		scriptUriManagedIdentity = config[0].ScriptUriManagedIdentity
+	}
+
+	return []VirtualMachineRunCommandScriptSourceSchema{
+		{
+			CommandId:                pointer.From(input.CommandId),
+			Script:                   pointer.From(input.Script),
+			ScriptUri:                scriptUri,
+			ScriptUriManagedIdentity: scriptUriManagedIdentity,
+		},
+	}
+}
+
+// runCommandPreviousInstanceIds returns the IDs of the instances recorded in a prior value of `instance_result`
+func runCommandPreviousInstanceIds(input interface{}) []string {
+	result := make([]string, 0)
+	raw, ok := input.([]interface{})
+	if !ok {
+		return result
+	}
+
+	for _, v := range raw {
+		item, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if instanceId, ok := item["instance_id"].(string); ok && instanceId != "" {
+			result = append(result, instanceId)
+		}
+	}
+
+	return result
+}
+
+// runCommandUntargetedInstanceIds returns the instances the command was previously run on which are no longer targeted
+func runCommandUntargetedInstanceIds(previous []string, current []string) []string {
+	result := make([]string, 0)
+	for _, instanceId := range previous {
+		if !slices.Contains(current, instanceId) {
+			result = append(result, instanceId)
+		}
+	}
+
+	return result
+}
+
+func flattenVirtualMachineScaleSetRunCommandInstanceResult(instanceId string, input *virtualmachinescalesetvmruncommands.VirtualMachineRunCommandInstanceView, expectedExitCodes []int64) VirtualMachineScaleSetRunCommandInstanceResult {
+	result := VirtualMachineScaleSetRunCommandInstanceResult{
+		InstanceId: instanceId,
+	}
+	if input == nil {
+		return result
+	}
+
+	result.ExitCode = pointer.From(input.ExitCode)
+	result.ExecutionState = string(pointer.From(input.ExecutionState))
+	result.Output = pointer.From(input.Output)
+	result.ErrorMessage = pointer.From(input.Error)
+	result.StartTime = pointer.From(input.StartTime)
+	result.EndTime = pointer.From(input.EndTime)
+	result.Succeeded = input.ExitCode != nil && slices.Contains(virtualMachineScaleSetRunCommandExpectedExitCodes(expectedExitCodes), *input.ExitCode)
+
+	// a command which timed out or was cancelled hasn't succeeded, regardless of the exit code reported
+	if state := pointer.From(input.ExecutionState); state != "" && state != virtualmachinescalesetvmruncommands.ExecutionStateSucceeded && state != virtualmachinescalesetvmruncommands.ExecutionStateFailed {
+		result.Succeeded = false
+	}
+
+	return result
+}
@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-03-01/virtualmachinescalesetvmruncommands"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VirtualMachineScaleSetRunCommandTestResource struct{}
+
+func TestAccVirtualMachineScaleSetRunCommand_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_run_command", "test")
+	r := VirtualMachineScaleSetRunCommandTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_result.#").HasValue("2"),
+				check.That(data.ResourceName).Key("succeeded_instance_count").HasValue("2"),
+				check.That(data.ResourceName).Key("failed_instance_count").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineScaleSetRunCommand_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_run_command", "test")
+	r := VirtualMachineScaleSetRunCommandTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualMachineScaleSetRunCommand_unexpectedExitCode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_run_command", "test")
+	r := VirtualMachineScaleSetRunCommandTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.exitCode(data, 1),
+			ExpectError: regexp.MustCompile("failed on 2 of 2 instances"),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("succeeded_instance_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineScaleSetRunCommand_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_run_command", "test")
+	r := VirtualMachineScaleSetRunCommandTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.selectedInstances(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_result.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("instance_ids"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_result.#").HasValue("2"),
+				check.That(data.ResourceName).Key("succeeded_instance_count").HasValue("2"),
+			),
+		},
+		data.ImportStep("protected_parameter", "run_as_password", "expected_exit_codes"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r VirtualMachineScaleSetRunCommandTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachineScaleSetRunCommandID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceId := state.Attributes["instance_result.0.instance_id"]
+	runCommandId := virtualmachinescalesetvmruncommands.NewVirtualMachineScaleSetVirtualMachineRunCommandID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, instanceId, id.RunCommandName)
+
+	resp, err := clients.Compute.VirtualMachineScaleSetVMRunCommandsClient.Get(ctx, runCommandId, virtualmachinescalesetvmruncommands.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", runCommandId, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r VirtualMachineScaleSetRunCommandTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_run_command" "test" {
+  name                         = "acctestvmssrc-%d"
+  location                     = azurerm_resource_group.test.location
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+
+  source {
+    script = "echo 'hello world'"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualMachineScaleSetRunCommandTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_run_command" "import" {
+  name                         = azurerm_virtual_machine_scale_set_run_command.test.name
+  location                     = azurerm_virtual_machine_scale_set_run_command.test.location
+  virtual_machine_scale_set_id = azurerm_virtual_machine_scale_set_run_command.test.virtual_machine_scale_set_id
+
+  source {
+    script = azurerm_virtual_machine_scale_set_run_command.test.source.0.script
+  }
+}
+`, r.basic(data))
+}
+
+func (r VirtualMachineScaleSetRunCommandTestResource) exitCode(data acceptance.TestData, exitCode int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_run_command" "test" {
+  name                         = "acctestvmssrc-%d"
+  location                     = azurerm_resource_group.test.location
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+
+  source {
+    script = "echo 'hello world' && exit %d"
+  }
+}
+`, r.template(data), data.RandomInteger, exitCode)
+}
+
+func (r VirtualMachineScaleSetRunCommandTestResource) selectedInstances(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_run_command" "test" {
+  name                         = "acctestvmssrc-%d"
+  location                     = azurerm_resource_group.test.location
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+  instance_ids                 = ["0"]
+
+  source {
+    script = "echo 'hello world'"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualMachineScaleSetRunCommandTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_run_command" "test" {
+  name                         = "acctestvmssrc-%d"
+  location                     = azurerm_resource_group.test.location
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+  instance_ids                 = ["0", "1"]
+  expected_exit_codes          = [0, 2]
+  run_as_user                  = "adminuser"
+  run_as_password              = "P@ssword1234!"
+
+  source {
+    script = "echo \"$GREETING $NAME\" && exit 2"
+  }
+
+  parameter {
+    name  = "GREETING"
+    value = "hello"
+  }
+
+  protected_parameter {
+    name  = "NAME"
+    value = "world"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualMachineScaleSetRunCommandTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vmssrc-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestnw-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_linux_virtual_machine_scale_set" "test" {
+  name                            = "acctestvmss-%[1]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  sku                             = "Standard_F2"
+  instances                       = 2
+  overprovision                   = false
+  admin_username                  = "adminuser"
+  admin_password                  = "P@ssword1234!"
+  disable_password_authentication = false
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+
+  os_disk {
+    storage_account_type = "Standard_LRS"
+    caching              = "ReadWrite"
+  }
+
+  network_interface {
+    name    = "example"
+    primary = true
+
+    ip_configuration {
+      name      = "internal"
+      primary   = true
+      subnet_id = azurerm_subnet.test.id
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
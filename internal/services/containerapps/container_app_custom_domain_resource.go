@@ -60,9 +60,11 @@ func (a ContainerAppCustomDomainResource) Arguments() map[string]*pluginsdk.Sche
 			ValidateFunc: managedenvironments.ValidateCertificateID,
 		},
 
+		// Computed since binding an `azurerm_container_app_environment_managed_certificate` updates this outside of this resource
 		"certificate_binding_type": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ForceNew:     true,
 			ValidateFunc: validation.StringInSlice(containerapps.PossibleValuesForBindingType(), false),
 			Description:  "The Binding type. Possible values include `Disabled` and `SniEnabled`.",
@@ -73,8 +75,9 @@ func (a ContainerAppCustomDomainResource) Arguments() map[string]*pluginsdk.Sche
 func (a ContainerAppCustomDomainResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"container_app_environment_managed_certificate_id": {
-			Type:     pluginsdk.TypeString,
-			Computed: true,
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The ID of the Container App Environment Managed Certificate bound to this Custom Domain.",
 		},
 	}
 }
@@ -307,3 +310,53 @@ func (a ContainerAppCustomDomainResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+// updateContainerAppCustomDomainCertificate sets the certificate and binding type for an existing Custom Domain on a Container App,
+// this is used to bind a Managed Certificate once it has been issued, since the Custom Domain must exist before the certificate can be requested.
+func updateContainerAppCustomDomainCertificate(ctx context.Context, client *containerapps.ContainerAppsClient, id parse.ContainerAppCustomDomainId, certificateId *string, bindingType containerapps.BindingType) error {
+	containerAppId := containerapps.NewContainerAppID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName)
+
+	locks.ByID(containerAppId.ID())
+	defer locks.UnlockByID(containerAppId.ID())
+
+	containerApp, err := client.Get(ctx, containerAppId)
+	if err != nil || containerApp.Model == nil {
+		return fmt.Errorf("retrieving %s to update %s: %+v", containerAppId, id, err)
+	}
+
+	model := containerApp.Model
+	if model.Properties == nil || model.Properties.Configuration == nil || model.Properties.Configuration.Ingress == nil || model.Properties.Configuration.Ingress.CustomDomains == nil {
+		return fmt.Errorf("could not read Ingress configuration for %s", containerAppId)
+	}
+
+	found := false
+	customDomains := *model.Properties.Configuration.Ingress.CustomDomains
+	for i, v := range customDomains {
+		if strings.EqualFold(v.Name, id.CustomDomainName) {
+			found = true
+			customDomains[i].CertificateId = certificateId
+			customDomains[i].BindingType = pointer.To(bindingType)
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("%s was not found", id)
+	}
+
+	model.Properties.Configuration.Ingress.CustomDomains = pointer.To(customDomains)
+
+	// Delta-updates need the secrets back from the list API, or we'll end up removing them or erroring out.
+	secretsResp, err := client.ListSecrets(ctx, containerAppId)
+	if err != nil || secretsResp.Model == nil {
+		if !response.WasStatusCode(secretsResp.HttpResponse, http.StatusNoContent) {
+			return fmt.Errorf("retrieving secrets for update for %s: %+v", containerAppId, err)
+		}
+	}
+	model.Properties.Configuration.Secrets = helpers.UnpackContainerSecretsCollection(secretsResp.Model)
+
+	if err := client.CreateOrUpdateThenPoll(ctx, containerAppId, *model); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/javacomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppEnvironmentJavaComponentResource struct{}
+
+type ContainerAppEnvironmentJavaComponentModel struct {
+	Name                      string                          `tfschema:"name"`
+	ContainerAppEnvironmentId string                          `tfschema:"container_app_environment_id"`
+	ComponentType             string                          `tfschema:"component_type"`
+	Configuration             map[string]string               `tfschema:"configuration"`
+	MinReplicas               int64                           `tfschema:"min_replicas"`
+	MaxReplicas               int64                           `tfschema:"max_replicas"`
+	ServiceBinds              []JavaComponentServiceBindModel `tfschema:"service_bind"`
+
+	IngressFqdn string `tfschema:"ingress_fqdn"`
+}
+
+type JavaComponentServiceBindModel struct {
+	Name      string `tfschema:"name"`
+	ServiceId string `tfschema:"service_id"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentJavaComponentResource{}
+
+func (r ContainerAppEnvironmentJavaComponentResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentJavaComponentModel{}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) ResourceType() string {
+	return "azurerm_container_app_environment_java_component"
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return javacomponents.ValidateJavaComponentID
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.JavaComponentName,
+			Description:  "The name for this Java Component.",
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: javacomponents.ValidateManagedEnvironmentID,
+			Description:  "The ID of the Container App Environment to which this Java Component belongs.",
+		},
+
+		"component_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(javacomponents.PossibleValuesForJavaComponentType(), false),
+			Description:  "The type of the Java Component. Possible values are `SpringBootAdmin`, `SpringCloudConfig` and `SpringCloudEureka`.",
+		},
+
+		"configuration": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			Description: "A mapping of configuration property names to values for the Java Component. e.g. `spring.cloud.config.server.git.uri`.",
+		},
+
+		"min_replicas": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 30),
+			Description:  "The minimum number of replicas for the Java Component. Defaults to `1`.",
+		},
+
+		"max_replicas": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 30),
+			Description:  "The maximum number of replicas for the Java Component. Defaults to `1`.",
+		},
+
+		"service_bind": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The name of the service bind.",
+					},
+
+					"service_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: javacomponents.ValidateJavaComponentID,
+						Description:  "The ID of the Java Component to bind to.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"ingress_fqdn": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The FQDN of the Java Component's ingress, for component types which expose one.",
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			var model ContainerAppEnvironmentJavaComponentModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			environmentId, err := javacomponents.ParseManagedEnvironmentID(model.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := javacomponents.NewJavaComponentID(environmentId.SubscriptionId, environmentId.ResourceGroupName, environmentId.ManagedEnvironmentName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			javaComponent := javacomponents.JavaComponent{
+				Properties: expandJavaComponentProperties(model),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, javaComponent); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppEnvironmentJavaComponentModel{
+				Name:                      id.JavaComponentName,
+				ContainerAppEnvironmentId: javacomponents.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID(),
+			}
+
+			if model := existing.Model; model != nil && model.Properties != nil {
+				props := model.Properties.JavaComponentProperties()
+				state.ComponentType = string(props.ComponentType)
+				state.Configuration = flattenJavaComponentConfiguration(props.Configurations)
+				state.ServiceBinds = flattenJavaComponentServiceBinds(props.ServiceBinds)
+
+				if scale := props.Scale; scale != nil {
+					state.MinReplicas = pointer.From(scale.MinReplicas)
+					state.MaxReplicas = pointer.From(scale.MaxReplicas)
+				}
+
+				switch v := model.Properties.(type) {
+				case javacomponents.SpringBootAdminComponent:
+					if v.Ingress != nil {
+						state.IngressFqdn = pointer.From(v.Ingress.Fqdn)
+					}
+				case javacomponents.SpringCloudEurekaComponent:
+					if v.Ingress != nil {
+						state.IngressFqdn = pointer.From(v.Ingress.Fqdn)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppEnvironmentJavaComponentModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			// the properties are polymorphic on `componentType`, so the full payload is sent rather than patching the existing model
+			javaComponent := javacomponents.JavaComponent{
+				Properties: expandJavaComponentProperties(model),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, javaComponent); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandJavaComponentProperties(model ContainerAppEnvironmentJavaComponentModel) javacomponents.JavaComponentProperties {
+	configurations := expandJavaComponentConfiguration(model.Configuration)
+	scale := &javacomponents.JavaComponentPropertiesScale{
+		MinReplicas: pointer.To(model.MinReplicas),
+		MaxReplicas: pointer.To(model.MaxReplicas),
+	}
+	serviceBinds := expandJavaComponentServiceBinds(model.ServiceBinds)
+
+	switch javacomponents.JavaComponentType(model.ComponentType) {
+	case javacomponents.JavaComponentTypeSpringBootAdmin:
+		return javacomponents.SpringBootAdminComponent{
+			ComponentType:  javacomponents.JavaComponentTypeSpringBootAdmin,
+			Configurations: configurations,
+			Scale:          scale,
+			ServiceBinds:   serviceBinds,
+		}
+	case javacomponents.JavaComponentTypeSpringCloudEureka:
+		return javacomponents.SpringCloudEurekaComponent{
+			ComponentType:  javacomponents.JavaComponentTypeSpringCloudEureka,
+			Configurations: configurations,
+			Scale:          scale,
+			ServiceBinds:   serviceBinds,
+		}
+	default:
+		return javacomponents.SpringCloudConfigComponent{
+			ComponentType:  javacomponents.JavaComponentTypeSpringCloudConfig,
+			Configurations: configurations,
+			Scale:          scale,
+			ServiceBinds:   serviceBinds,
+		}
+	}
+}
+
+func expandJavaComponentConfiguration(input map[string]string) *[]javacomponents.JavaComponentConfigurationProperty {
+	result := make([]javacomponents.JavaComponentConfigurationProperty, 0)
+
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		result = append(result, javacomponents.JavaComponentConfigurationProperty{
+			PropertyName: pointer.To(k),
+			Value:        pointer.To(input[k]),
+		})
+	}
+
+	return &result
+}
+
+func flattenJavaComponentConfiguration(input *[]javacomponents.JavaComponentConfigurationProperty) map[string]string {
+	result := make(map[string]string)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		if v.PropertyName == nil {
+			continue
+		}
+		result[*v.PropertyName] = pointer.From(v.Value)
+	}
+
+	return result
+}
+
+func expandJavaComponentServiceBinds(input []JavaComponentServiceBindModel) *[]javacomponents.JavaComponentServiceBind {
+	result := make([]javacomponents.JavaComponentServiceBind, 0)
+
+	for _, v := range input {
+		result = append(result, javacomponents.JavaComponentServiceBind{
+			Name:      pointer.To(v.Name),
+			ServiceId: pointer.To(v.ServiceId),
+		})
+	}
+
+	return &result
+}
+
+func flattenJavaComponentServiceBinds(input *[]javacomponents.JavaComponentServiceBind) []JavaComponentServiceBindModel {
+	result := make([]JavaComponentServiceBindModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		serviceId := pointer.From(v.ServiceId)
+		if parsed, err := javacomponents.ParseJavaComponentIDInsensitively(serviceId); err == nil {
+			serviceId = parsed.ID()
+		}
+
+		result = append(result, JavaComponentServiceBindModel{
+			Name:      pointer.From(v.Name),
+			ServiceId: serviceId,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,444 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/managedcertificates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppEnvironmentManagedCertificateResource struct{}
+
+type ContainerAppEnvironmentManagedCertificateModel struct {
+	Name                      string                 `tfschema:"name"`
+	ContainerAppEnvironmentId string                 `tfschema:"container_app_environment_id"`
+	SubjectName               string                 `tfschema:"subject_name"`
+	DomainControlValidation   string                 `tfschema:"domain_control_validation"`
+	CustomDomainId            string                 `tfschema:"container_app_custom_domain_id"`
+	Tags                      map[string]interface{} `tfschema:"tags"`
+
+	ValidationToken string `tfschema:"validation_token"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentManagedCertificateResource{}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentManagedCertificateModel{}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) ResourceType() string {
+	return "azurerm_container_app_environment_managed_certificate"
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managedcertificates.ValidateManagedCertificateID
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CertificateName,
+			Description:  "The name of the Container Apps Environment Managed Certificate.",
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedcertificates.ValidateManagedEnvironmentID,
+			Description:  "The Container App Managed Environment ID to issue this Managed Certificate in.",
+		},
+
+		"subject_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The fully qualified domain name the Managed Certificate should be issued for.",
+		},
+
+		"domain_control_validation": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(managedcertificates.PossibleValuesForManagedCertificateDomainControlValidation(), false),
+			Description:  "The method used to validate ownership of the domain. Possible values are `CNAME`, `HTTP` and `TXT`.",
+		},
+
+		"container_app_custom_domain_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerAppCustomDomainId,
+			Description:  "The ID of the Container App Custom Domain to bind this Managed Certificate to once it has been issued.",
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"validation_token": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The token used to validate ownership of the domain when `domain_control_validation` is `TXT`.",
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedCertificatesClient
+			environmentsClient := metadata.Client.ContainerApps.ManagedEnvironmentClient
+
+			var model ContainerAppEnvironmentManagedCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			envId, err := managedenvironments.ParseManagedEnvironmentID(model.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := managedcertificates.NewManagedCertificateID(envId.SubscriptionId, envId.ResourceGroupName, envId.ManagedEnvironmentName, model.Name)
+
+			var customDomainId *parse.ContainerAppCustomDomainId
+			if model.CustomDomainId != "" {
+				customDomainId, err = parse.ContainerAppCustomDomainID(model.CustomDomainId)
+				if err != nil {
+					return err
+				}
+			}
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			env, err := environmentsClient.Get(ctx, *envId)
+			if err != nil || env.Model == nil {
+				return fmt.Errorf("retrieving %s for %s: %+v", *envId, id, err)
+			}
+
+			validationType := managedcertificates.ManagedCertificateDomainControlValidation(model.DomainControlValidation)
+			certificate := managedcertificates.ManagedCertificate{
+				Location: env.Model.Location,
+				Properties: &managedcertificates.ManagedCertificateProperties{
+					DomainControlValidation: pointer.To(validationType),
+					SubjectName:             pointer.To(model.SubjectName),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			// the provisioning state remains `Pending` until the domain has been validated, so rather than polling the
+			// long-running operation we poll the certificate ourselves to surface a meaningful error when validation fails
+			if _, err := client.CreateOrUpdate(ctx, id, certificate); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			// the certificate exists from this point on, so the ID is set before polling to ensure it's tracked in the
+			// state (as tainted) should validation or issuance fail
+			metadata.SetID(id)
+
+			// for TXT validation the DNS record can only be created once the validation token has been generated,
+			// so unless the certificate needs to be bound we only wait for the token to be available here
+			if validationType == managedcertificates.ManagedCertificateDomainControlValidationTXT && customDomainId == nil {
+				if err := waitForManagedCertificateValidationToken(ctx, client, id); err != nil {
+					return err
+				}
+			} else if err := waitForManagedCertificateIssued(ctx, client, id); err != nil {
+				return err
+			}
+
+			if customDomainId != nil {
+				if err := updateContainerAppCustomDomainCertificate(ctx, metadata.Client.ContainerApps.ContainerAppClient, *customDomainId, pointer.To(id.ID()), containerapps.BindingTypeSniEnabled); err != nil {
+					return fmt.Errorf("binding %s to %s: %+v", id, *customDomainId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedCertificatesClient
+
+			id, err := managedcertificates.ParseManagedCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppEnvironmentManagedCertificateModel{
+				Name:                      id.ManagedCertificateName,
+				ContainerAppEnvironmentId: managedcertificates.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID(),
+				// the binding is held on the Container App, which the Custom Domain resource reads back
+				CustomDomainId: metadata.ResourceData.Get("container_app_custom_domain_id").(string),
+				// the validation token is removed from the API once the certificate has been issued, so retain it from state
+				ValidationToken: metadata.ResourceData.Get("validation_token").(string),
+			}
+
+			if model := existing.Model; model != nil {
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.SubjectName = pointer.From(props.SubjectName)
+					state.DomainControlValidation = string(pointer.From(props.DomainControlValidation))
+					if token := pointer.From(props.ValidationToken); token != "" {
+						state.ValidationToken = token
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedCertificatesClient
+
+			id, err := managedcertificates.ParseManagedCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppEnvironmentManagedCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				patch := managedcertificates.ManagedCertificatePatch{
+					Tags: tags.Expand(model.Tags),
+				}
+
+				if _, err := client.Update(ctx, *id, patch); err != nil {
+					return fmt.Errorf("updating tags for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedCertificatesClient
+
+			id, err := managedcertificates.ParseManagedCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppEnvironmentManagedCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			// a certificate can't be deleted whilst it's bound, so unbind it from the Custom Domain first if it's still in use
+			if model.CustomDomainId != "" {
+				customDomainId, err := parse.ContainerAppCustomDomainID(model.CustomDomainId)
+				if err != nil {
+					return err
+				}
+
+				bound, err := containerAppCustomDomainUsesCertificate(ctx, metadata.Client.ContainerApps.ContainerAppClient, *customDomainId, id.ID())
+				if err != nil {
+					return err
+				}
+
+				if bound {
+					if err := updateContainerAppCustomDomainCertificate(ctx, metadata.Client.ContainerApps.ContainerAppClient, *customDomainId, nil, containerapps.BindingTypeDisabled); err != nil {
+						return fmt.Errorf("unbinding %s from %s: %+v", *id, *customDomainId, err)
+					}
+				}
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func waitForManagedCertificateValidationToken(ctx context.Context, client *managedcertificates.ManagedCertificatesClient, id managedcertificates.ManagedCertificateId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context was missing a deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Pending"},
+		Target:     []string{"Available"},
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				return resp, "Error", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if resp.Model == nil || resp.Model.Properties == nil {
+				return nil, "Error", fmt.Errorf("`properties` was missing from the response")
+			}
+
+			props := resp.Model.Properties
+			if state := pointer.From(props.ProvisioningState); state == managedcertificates.CertificateProvisioningStateFailed || state == managedcertificates.CertificateProvisioningStateCanceled {
+				return resp, string(state), fmt.Errorf("requesting the validation token failed: %s", pointer.From(props.Error))
+			}
+
+			if pointer.From(props.ValidationToken) == "" {
+				return resp, "Pending", nil
+			}
+
+			return resp, "Available", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the validation token for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func waitForManagedCertificateIssued(ctx context.Context, client *managedcertificates.ManagedCertificatesClient, id managedcertificates.ManagedCertificateId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context was missing a deadline")
+	}
+
+	var lastProps managedcertificates.ManagedCertificateProperties
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(managedcertificates.CertificateProvisioningStatePending),
+		},
+		Target: []string{
+			string(managedcertificates.CertificateProvisioningStateSucceeded),
+		},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				return resp, "Error", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if resp.Model == nil || resp.Model.Properties == nil {
+				return nil, "Error", fmt.Errorf("`properties` was missing from the response")
+			}
+
+			lastProps = *resp.Model.Properties
+			state := pointer.From(lastProps.ProvisioningState)
+			if state == managedcertificates.CertificateProvisioningStateFailed || state == managedcertificates.CertificateProvisioningStateCanceled {
+				return resp, string(state), fmt.Errorf("domain validation failed: %s", managedCertificateValidationFailure(lastProps))
+			}
+
+			return resp, string(state), nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		if pointer.From(lastProps.ProvisioningState) == managedcertificates.CertificateProvisioningStatePending {
+			return fmt.Errorf("waiting for %s to be issued, the domain could not be validated before the timeout: %s", id, managedCertificateValidationFailure(lastProps))
+		}
+		return fmt.Errorf("waiting for %s to be issued: %+v", id, err)
+	}
+
+	return nil
+}
+
+// managedCertificateValidationFailure combines the error returned from the API with the DNS configuration
+// required for the validation method, since the API error alone rarely says which record is missing
+func managedCertificateValidationFailure(props managedcertificates.ManagedCertificateProperties) string {
+	subjectName := pointer.From(props.SubjectName)
+
+	var requirement string
+	switch pointer.From(props.DomainControlValidation) {
+	case managedcertificates.ManagedCertificateDomainControlValidationTXT:
+		requirement = fmt.Sprintf("a TXT record named `_dnsauth.%s` with the value %q must exist", subjectName, pointer.From(props.ValidationToken))
+	case managedcertificates.ManagedCertificateDomainControlValidationCNAME:
+		requirement = fmt.Sprintf("a CNAME record for `%s` must point to the FQDN of the Container App", subjectName)
+	default:
+		requirement = fmt.Sprintf("`%s` must resolve to the Container App Environment (using an A record for the static IP or a CNAME record for the Container App FQDN) and the Container App must accept HTTP traffic on port 80", subjectName)
+	}
+
+	if apiError := strings.TrimSpace(pointer.From(props.Error)); apiError != "" {
+		return fmt.Sprintf("%s - %s", apiError, requirement)
+	}
+
+	return requirement
+}
+
+func containerAppCustomDomainUsesCertificate(ctx context.Context, client *containerapps.ContainerAppsClient, id parse.ContainerAppCustomDomainId, certificateId string) (bool, error) {
+	containerAppId := containerapps.NewContainerAppID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName)
+
+	resp, err := client.Get(ctx, containerAppId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return false, nil
+		}
+		return false, fmt.Errorf("retrieving %s: %+v", containerAppId, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.Configuration == nil || resp.Model.Properties.Configuration.Ingress == nil {
+		return false, nil
+	}
+
+	for _, v := range pointer.From(resp.Model.Properties.Configuration.Ingress.CustomDomains) {
+		if strings.EqualFold(v.Name, id.CustomDomainName) && strings.EqualFold(pointer.From(v.CertificateId), certificateId) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/managedcertificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppEnvironmentManagedCertificateResource struct{}
+
+func TestAccContainerAppEnvironmentManagedCertificate_http(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skipf("Skipping as either ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_managed_certificate", "test")
+	r := ContainerAppEnvironmentManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.http(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_container_app_custom_domain.test").Key("container_app_environment_managed_certificate_id").Exists(),
+				check.That("azurerm_container_app_custom_domain.test").Key("certificate_binding_type").HasValue("SniEnabled"),
+			),
+		},
+		data.ImportStep("container_app_custom_domain_id"),
+	})
+}
+
+func TestAccContainerAppEnvironmentManagedCertificate_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skipf("Skipping as either ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_managed_certificate", "test")
+	r := ContainerAppEnvironmentManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.txt(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppEnvironmentManagedCertificate_txt(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skipf("Skipping as either ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_managed_certificate", "test")
+	r := ContainerAppEnvironmentManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.txt(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("validation_token").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("validation_token"),
+		{
+			Config: r.txtTags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("validation_token"),
+	})
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := managedcertificates.ParseManagedCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.ManagedCertificatesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) http(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider azurerm {
+  features {}
+}
+
+%s
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "containerapp%[2]d"
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  zone_name           = data.azurerm_dns_zone.test.name
+  ttl                 = 300
+  record              = azurerm_container_app.test.ingress[0].fqdn
+}
+
+resource "azurerm_container_app_custom_domain" "test" {
+  name             = trimsuffix(azurerm_dns_cname_record.test.fqdn, ".")
+  container_app_id = azurerm_container_app.test.id
+}
+
+resource "azurerm_container_app_environment_managed_certificate" "test" {
+  name                           = "acctest-mcert%[2]d"
+  container_app_environment_id   = azurerm_container_app_environment.test.id
+  subject_name                   = azurerm_container_app_custom_domain.test.name
+  domain_control_validation      = "HTTP"
+  container_app_custom_domain_id = azurerm_container_app_custom_domain.test.id
+}
+`, ContainerAppCustomDomainResource{}.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) txt(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider azurerm {
+  features {}
+}
+
+%s
+
+resource "azurerm_container_app_custom_domain" "test" {
+  name             = trimsuffix(trimprefix(azurerm_dns_txt_record.test.fqdn, "asuid."), ".")
+  container_app_id = azurerm_container_app.test.id
+}
+
+resource "azurerm_container_app_environment_managed_certificate" "test" {
+  name                         = "acctest-mcert%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  subject_name                 = azurerm_container_app_custom_domain.test.name
+  domain_control_validation    = "TXT"
+}
+`, ContainerAppCustomDomainResource{}.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) txtTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider azurerm {
+  features {}
+}
+
+%s
+
+resource "azurerm_container_app_custom_domain" "test" {
+  name             = trimsuffix(trimprefix(azurerm_dns_txt_record.test.fqdn, "asuid."), ".")
+  container_app_id = azurerm_container_app.test.id
+}
+
+resource "azurerm_container_app_environment_managed_certificate" "test" {
+  name                         = "acctest-mcert%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  subject_name                 = azurerm_container_app_custom_domain.test.name
+  domain_control_validation    = "TXT"
+
+  tags = {
+    environment = "test"
+  }
+}
+`, ContainerAppCustomDomainResource{}.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_environment_managed_certificate" "import" {
+  name                         = azurerm_container_app_environment_managed_certificate.test.name
+  container_app_environment_id = azurerm_container_app_environment_managed_certificate.test.container_app_environment_id
+  subject_name                 = azurerm_container_app_environment_managed_certificate.test.subject_name
+  domain_control_validation    = azurerm_container_app_environment_managed_certificate.test.domain_control_validation
+}
+`, r.txt(data))
+}
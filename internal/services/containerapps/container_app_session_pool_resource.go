@@ -0,0 +1,764 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappssessionpools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppSessionPoolResource struct{}
+
+type ContainerAppSessionPoolModel struct {
+	Name                      string                                     `tfschema:"name"`
+	ResourceGroup             string                                     `tfschema:"resource_group_name"`
+	Location                  string                                     `tfschema:"location"`
+	ContainerAppEnvironmentId string                                     `tfschema:"container_app_environment_id"`
+	ContainerType             string                                     `tfschema:"container_type"`
+	PoolManagementType        string                                     `tfschema:"pool_management_type"`
+	MaxConcurrentSessions     int64                                      `tfschema:"max_concurrent_sessions"`
+	ReadySessionInstances     int64                                      `tfschema:"ready_session_instances"`
+	LifecycleType             string                                     `tfschema:"lifecycle_type"`
+	CooldownPeriodInSeconds   int64                                      `tfschema:"cooldown_period_in_seconds"`
+	MaxAlivePeriodInSeconds   int64                                      `tfschema:"max_alive_period_in_seconds"`
+	NetworkEgressEnabled      bool                                       `tfschema:"network_egress_enabled"`
+	Secrets                   []SessionPoolSecretModel                   `tfschema:"secret"`
+	Containers                []SessionPoolContainerModel                `tfschema:"container"`
+	IngressTargetPort         int64                                      `tfschema:"ingress_target_port"`
+	Registries                []helpers.Registry                         `tfschema:"registry"`
+	Identity                  []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Tags                      map[string]interface{}                     `tfschema:"tags"`
+
+	PoolManagementEndpoint string `tfschema:"pool_management_endpoint"`
+	NodeCount              int64  `tfschema:"node_count"`
+}
+
+type SessionPoolSecretModel struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type SessionPoolContainerModel struct {
+	Name    string                    `tfschema:"name"`
+	Image   string                    `tfschema:"image"`
+	CPU     float64                   `tfschema:"cpu"`
+	Memory  string                    `tfschema:"memory"`
+	Command []string                  `tfschema:"command"`
+	Args    []string                  `tfschema:"args"`
+	Env     []helpers.ContainerEnvVar `tfschema:"env"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppSessionPoolResource{}
+
+var _ sdk.ResourceWithCustomizeDiff = ContainerAppSessionPoolResource{}
+
+func (r ContainerAppSessionPoolResource) ModelObject() interface{} {
+	return &ContainerAppSessionPoolModel{}
+}
+
+func (r ContainerAppSessionPoolResource) ResourceType() string {
+	return "azurerm_container_app_session_pool"
+}
+
+func (r ContainerAppSessionPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerappssessionpools.ValidateSessionPoolID
+}
+
+func (r ContainerAppSessionPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.SessionPoolName,
+			Description:  "The name for this Container App Session Pool.",
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"container_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(containerappssessionpools.PossibleValuesForContainerType(), false),
+			Description:  "The type of container used by the sessions in this pool. Possible values are `CustomContainer` and `PythonLTS`.",
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+			Description:  "The ID of the Container App Environment in which the sessions will run. Required when `container_type` is `CustomContainer`.",
+		},
+
+		"pool_management_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(containerappssessionpools.PoolManagementTypeDynamic),
+			ValidateFunc: validation.StringInSlice(containerappssessionpools.PossibleValuesForPoolManagementType(), false),
+			Description:  "The pool management type of the Session Pool. Possible values are `Dynamic` and `Manual`. Defaults to `Dynamic`.",
+		},
+
+		"max_concurrent_sessions": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of sessions that can run at the same time. Defaults to `10`.",
+		},
+
+		"ready_session_instances": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The number of sessions that are kept ready in the pool.",
+		},
+
+		"lifecycle_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(containerappssessionpools.LifecycleTypeTimed),
+			ValidateFunc: validation.StringInSlice(containerappssessionpools.PossibleValuesForLifecycleType(), false),
+			Description:  "The lifecycle type of the sessions in the pool. Possible values are `OnContainerExit` and `Timed`. Defaults to `Timed`.",
+		},
+
+		"cooldown_period_in_seconds": {
+			Type:          pluginsdk.TypeInt,
+			Optional:      true,
+			Computed:      true,
+			ValidateFunc:  validation.IntBetween(300, 3600),
+			ConflictsWith: []string{"max_alive_period_in_seconds"},
+			Description:   "The number of seconds a session can be idle before it's terminated, when `lifecycle_type` is `Timed`.",
+		},
+
+		"max_alive_period_in_seconds": {
+			Type:          pluginsdk.TypeInt,
+			Optional:      true,
+			Computed:      true,
+			ValidateFunc:  validation.IntBetween(300, 86400),
+			ConflictsWith: []string{"cooldown_period_in_seconds"},
+			Description:   "The maximum number of seconds a session can run, when `lifecycle_type` is `OnContainerExit`.",
+		},
+
+		"network_egress_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Should sessions in the pool be allowed outbound network access? Defaults to `false`.",
+		},
+
+		"secret": {
+			Type:      pluginsdk.TypeSet,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.SecretName,
+						Description:  "The secret name.",
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The value for this secret.",
+					},
+				},
+			},
+		},
+
+		"container": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.ContainerAppContainerName,
+						Description:  "The name of the container.",
+					},
+
+					"image": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The image to use to create the container.",
+					},
+
+					"cpu": {
+						Type:         pluginsdk.TypeFloat,
+						Required:     true,
+						ValidateFunc: validation.FloatAtLeast(0.25),
+						Description:  "The amount of vCPU to allocate to the container.",
+					},
+
+					"memory": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The amount of memory to allocate to the container. e.g. `0.5Gi`.",
+					},
+
+					"command": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						Description: "A command to pass to the container to override the default.",
+					},
+
+					"args": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						Description: "A list of extra arguments to pass to the container.",
+					},
+
+					"env": helpers.ContainerEnvVarSchema(),
+				},
+			},
+		},
+
+		"ingress_target_port": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IsPortNumber,
+			Description:  "The port on which the custom container listens for session requests.",
+		},
+
+		"registry": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"server": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The hostname for the Container Registry.",
+					},
+
+					"username": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The username to use for this Container Registry.",
+					},
+
+					"password_secret_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The name of the Secret Reference containing the password value for this user on the Container Registry.",
+					},
+
+					"identity": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "ID of the System or User Managed Identity used to pull images from the Container Registry",
+					},
+				},
+			},
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"pool_management_endpoint": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The endpoint used to manage sessions in this pool.",
+		},
+
+		"node_count": {
+			Type:        pluginsdk.TypeInt,
+			Computed:    true,
+			Description: "The number of nodes the Session Pool is using.",
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ContainerAppSessionPoolModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return err
+			}
+
+			rd := metadata.ResourceDiff
+			if model.ContainerType == string(containerappssessionpools.ContainerTypeCustomContainer) {
+				if model.ContainerAppEnvironmentId == "" && rd.NewValueKnown("container_app_environment_id") {
+					return fmt.Errorf("`container_app_environment_id` must be specified when `container_type` is `CustomContainer`")
+				}
+				if len(model.Containers) == 0 && rd.NewValueKnown("container") {
+					return fmt.Errorf("at least one `container` block must be specified when `container_type` is `CustomContainer`")
+				}
+			} else if len(model.Containers) > 0 || len(model.Registries) > 0 || model.IngressTargetPort != 0 {
+				return fmt.Errorf("`container`, `registry` and `ingress_target_port` can only be specified when `container_type` is `CustomContainer`")
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ContainerAppSessionPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			id := containerappssessionpools.NewSessionPoolID(subscriptionId, model.ResourceGroup, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			ident, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			sessionPool := containerappssessionpools.SessionPool{
+				Identity: ident,
+				Location: location.Normalize(model.Location),
+				Properties: &containerappssessionpools.SessionPoolProperties{
+					ContainerType:               pointer.To(containerappssessionpools.ContainerType(model.ContainerType)),
+					CustomContainerTemplate:     expandSessionPoolCustomContainerTemplate(model),
+					DynamicPoolConfiguration:    expandSessionPoolDynamicPoolConfiguration(model),
+					PoolManagementType:          pointer.To(containerappssessionpools.PoolManagementType(model.PoolManagementType)),
+					ScaleConfiguration:          expandSessionPoolScaleConfiguration(model),
+					Secrets:                     expandSessionPoolSecrets(model.Secrets),
+					SessionNetworkConfiguration: expandSessionPoolNetworkConfiguration(model.NetworkEgressEnabled),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			if model.ContainerAppEnvironmentId != "" {
+				sessionPool.Properties.EnvironmentId = pointer.To(model.ContainerAppEnvironmentId)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, sessionPool); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+
+			id, err := containerappssessionpools.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			state := ContainerAppSessionPoolModel{
+				Name:          id.SessionPoolName,
+				ResourceGroup: id.ResourceGroupName,
+			}
+
+			if model := existing.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if model.Identity != nil {
+					ident, err := identity.FlattenSystemAndUserAssignedMapToModel(pointer.To((identity.SystemAndUserAssignedMap)(*model.Identity)))
+					if err != nil {
+						return err
+					}
+					state.Identity = pointer.From(ident)
+				}
+
+				if props := model.Properties; props != nil {
+					if envId := pointer.From(props.EnvironmentId); envId != "" {
+						parsedEnvId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(envId)
+						if err != nil {
+							return err
+						}
+						state.ContainerAppEnvironmentId = parsedEnvId.ID()
+					}
+
+					state.ContainerType = string(pointer.From(props.ContainerType))
+					state.PoolManagementType = string(pointer.From(props.PoolManagementType))
+					state.PoolManagementEndpoint = pointer.From(props.PoolManagementEndpoint)
+					state.NodeCount = pointer.From(props.NodeCount)
+
+					if scale := props.ScaleConfiguration; scale != nil {
+						state.MaxConcurrentSessions = pointer.From(scale.MaxConcurrentSessions)
+						state.ReadySessionInstances = pointer.From(scale.ReadySessionInstances)
+					}
+
+					if dynamic := props.DynamicPoolConfiguration; dynamic != nil && dynamic.LifecycleConfiguration != nil {
+						lifecycle := dynamic.LifecycleConfiguration
+						state.LifecycleType = string(pointer.From(lifecycle.LifecycleType))
+						state.CooldownPeriodInSeconds = pointer.From(lifecycle.CooldownPeriodInSeconds)
+						state.MaxAlivePeriodInSeconds = pointer.From(lifecycle.MaxAlivePeriodInSeconds)
+					}
+
+					if network := props.SessionNetworkConfiguration; network != nil {
+						state.NetworkEgressEnabled = pointer.From(network.Status) == containerappssessionpools.SessionNetworkStatusEgressEnabled
+					}
+
+					if template := props.CustomContainerTemplate; template != nil {
+						state.Containers = flattenSessionPoolContainers(template.Containers)
+						if template.Ingress != nil {
+							state.IngressTargetPort = pointer.From(template.Ingress.TargetPort)
+						}
+						state.Registries = flattenSessionPoolRegistry(template.RegistryCredentials)
+					}
+				}
+			}
+
+			// The API does not return the values of secrets, so these are retained from the config
+			state.Secrets = make([]SessionPoolSecretModel, 0)
+			if v, ok := metadata.ResourceData.GetOk("secret"); ok {
+				for _, raw := range v.(*pluginsdk.Set).List() {
+					secret := raw.(map[string]interface{})
+					state.Secrets = append(state.Secrets, SessionPoolSecretModel{
+						Name:  secret["name"].(string),
+						Value: secret["value"].(string),
+					})
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+
+			id, err := containerappssessionpools.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppSessionPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			d := metadata.ResourceData
+			payload := containerappssessionpools.SessionPoolUpdatableProperties{
+				Properties: &containerappssessionpools.SessionPoolUpdatablePropertiesProperties{},
+			}
+
+			if d.HasChanges("container", "ingress_target_port", "registry") {
+				payload.Properties.CustomContainerTemplate = expandSessionPoolCustomContainerTemplate(model)
+			}
+
+			if d.HasChanges("lifecycle_type", "cooldown_period_in_seconds", "max_alive_period_in_seconds") {
+				payload.Properties.DynamicPoolConfiguration = expandSessionPoolDynamicPoolConfiguration(model)
+			}
+
+			if d.HasChanges("max_concurrent_sessions", "ready_session_instances") {
+				payload.Properties.ScaleConfiguration = expandSessionPoolScaleConfiguration(model)
+			}
+
+			if d.HasChange("secret") {
+				payload.Properties.Secrets = expandSessionPoolSecrets(model.Secrets)
+			}
+
+			if d.HasChange("network_egress_enabled") {
+				payload.Properties.SessionNetworkConfiguration = expandSessionPoolNetworkConfiguration(model.NetworkEgressEnabled)
+			}
+
+			if d.HasChange("identity") {
+				ident, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = ident
+			}
+
+			if d.HasChange("tags") {
+				payload.Tags = tags.Expand(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+
+			id, err := containerappssessionpools.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSessionPoolCustomContainerTemplate(model ContainerAppSessionPoolModel) *containerappssessionpools.CustomContainerTemplate {
+	if model.ContainerType != string(containerappssessionpools.ContainerTypeCustomContainer) {
+		return nil
+	}
+
+	template := &containerappssessionpools.CustomContainerTemplate{
+		Containers: expandSessionPoolContainers(model.Containers),
+	}
+
+	if model.IngressTargetPort != 0 {
+		template.Ingress = &containerappssessionpools.SessionIngress{
+			TargetPort: pointer.To(model.IngressTargetPort),
+		}
+	}
+
+	if len(model.Registries) > 0 {
+		registry := model.Registries[0]
+		credentials := &containerappssessionpools.SessionRegistryCredentials{
+			Server: pointer.To(registry.Server),
+		}
+		if registry.Identity != "" {
+			credentials.Identity = pointer.To(registry.Identity)
+		}
+		if registry.UserName != "" {
+			credentials.Username = pointer.To(registry.UserName)
+		}
+		if registry.PasswordSecretRef != "" {
+			credentials.PasswordSecretRef = pointer.To(registry.PasswordSecretRef)
+		}
+		template.RegistryCredentials = credentials
+	}
+
+	return template
+}
+
+func expandSessionPoolContainers(input []SessionPoolContainerModel) *[]containerappssessionpools.SessionContainer {
+	result := make([]containerappssessionpools.SessionContainer, 0)
+
+	for _, v := range input {
+		container := containerappssessionpools.SessionContainer{
+			Name:  pointer.To(v.Name),
+			Image: pointer.To(v.Image),
+			Resources: &containerappssessionpools.SessionContainerResources{
+				Cpu:    pointer.To(v.CPU),
+				Memory: pointer.To(v.Memory),
+			},
+		}
+
+		if len(v.Command) > 0 {
+			container.Command = pointer.To(v.Command)
+		}
+
+		if len(v.Args) > 0 {
+			container.Args = pointer.To(v.Args)
+		}
+
+		if len(v.Env) > 0 {
+			env := make([]containerappssessionpools.EnvironmentVar, 0)
+			for _, e := range v.Env {
+				envVar := containerappssessionpools.EnvironmentVar{
+					Name: pointer.To(e.Name),
+				}
+				if e.SecretReference != "" {
+					envVar.SecretRef = pointer.To(e.SecretReference)
+				} else {
+					envVar.Value = pointer.To(e.Value)
+				}
+				env = append(env, envVar)
+			}
+			container.Env = &env
+		}
+
+		result = append(result, container)
+	}
+
+	return &result
+}
+
+func expandSessionPoolDynamicPoolConfiguration(model ContainerAppSessionPoolModel) *containerappssessionpools.DynamicPoolConfiguration {
+	lifecycle := &containerappssessionpools.LifecycleConfiguration{
+		LifecycleType: pointer.To(containerappssessionpools.LifecycleType(model.LifecycleType)),
+	}
+
+	// the cooldown period only applies to `Timed` sessions, and the max alive period to `OnContainerExit` sessions
+	switch containerappssessionpools.LifecycleType(model.LifecycleType) {
+	case containerappssessionpools.LifecycleTypeTimed:
+		if model.CooldownPeriodInSeconds != 0 {
+			lifecycle.CooldownPeriodInSeconds = pointer.To(model.CooldownPeriodInSeconds)
+		}
+	case containerappssessionpools.LifecycleTypeOnContainerExit:
+		if model.MaxAlivePeriodInSeconds != 0 {
+			lifecycle.MaxAlivePeriodInSeconds = pointer.To(model.MaxAlivePeriodInSeconds)
+		}
+	}
+
+	return &containerappssessionpools.DynamicPoolConfiguration{
+		LifecycleConfiguration: lifecycle,
+	}
+}
+
+func expandSessionPoolScaleConfiguration(model ContainerAppSessionPoolModel) *containerappssessionpools.ScaleConfiguration {
+	scale := &containerappssessionpools.ScaleConfiguration{
+		MaxConcurrentSessions: pointer.To(model.MaxConcurrentSessions),
+	}
+
+	if model.ReadySessionInstances != 0 {
+		scale.ReadySessionInstances = pointer.To(model.ReadySessionInstances)
+	}
+
+	return scale
+}
+
+func expandSessionPoolSecrets(input []SessionPoolSecretModel) *[]containerappssessionpools.SessionPoolSecret {
+	result := make([]containerappssessionpools.SessionPoolSecret, 0)
+
+	for _, v := range input {
+		result = append(result, containerappssessionpools.SessionPoolSecret{
+			Name:  pointer.To(v.Name),
+			Value: pointer.To(v.Value),
+		})
+	}
+
+	return &result
+}
+
+func expandSessionPoolNetworkConfiguration(egressEnabled bool) *containerappssessionpools.SessionNetworkConfiguration {
+	status := containerappssessionpools.SessionNetworkStatusEgressDisabled
+	if egressEnabled {
+		status = containerappssessionpools.SessionNetworkStatusEgressEnabled
+	}
+
+	return &containerappssessionpools.SessionNetworkConfiguration{
+		Status: pointer.To(status),
+	}
+}
+
+func flattenSessionPoolContainers(input *[]containerappssessionpools.SessionContainer) []SessionPoolContainerModel {
+	result := make([]SessionPoolContainerModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		container := SessionPoolContainerModel{
+			Name:    pointer.From(v.Name),
+			Image:   pointer.From(v.Image),
+			Command: pointer.From(v.Command),
+			Args:    pointer.From(v.Args),
+		}
+
+		if resources := v.Resources; resources != nil {
+			container.CPU = pointer.From(resources.Cpu)
+			container.Memory = pointer.From(resources.Memory)
+		}
+
+		if v.Env != nil {
+			for _, e := range *v.Env {
+				container.Env = append(container.Env, helpers.ContainerEnvVar{
+					Name:            pointer.From(e.Name),
+					Value:           pointer.From(e.Value),
+					SecretReference: pointer.From(e.SecretRef),
+				})
+			}
+		}
+
+		result = append(result, container)
+	}
+
+	return result
+}
+
+func flattenSessionPoolRegistry(input *containerappssessionpools.SessionRegistryCredentials) []helpers.Registry {
+	if input == nil || pointer.From(input.Server) == "" {
+		return []helpers.Registry{}
+	}
+
+	return []helpers.Registry{
+		{
+			Server:            pointer.From(input.Server),
+			UserName:          pointer.From(input.Username),
+			PasswordSecretRef: pointer.From(input.PasswordSecretRef),
+			Identity:          pointer.From(input.Identity),
+		},
+	}
+}
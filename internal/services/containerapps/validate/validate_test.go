@@ -436,3 +436,98 @@ func TestValidateContainerAppContainerName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateSessionPoolName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+		},
+		{
+			Input: "a",
+		},
+		{
+			Input: "9a",
+		},
+		{
+			Input: "a-",
+		},
+		{
+			Input: "a--a",
+		},
+		{
+			Input: "Cannothavecapitals",
+		},
+		{
+			Input: "a-a",
+			Valid: true,
+		},
+		{
+			Input: "session-pool-1",
+			Valid: true,
+		},
+		{
+			Input: "a1234567890123456789012345678901",
+			Valid: true,
+		},
+		{
+			Input: "a12345678901234567890123456789012",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := SessionPoolName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %s: %+v", tc.Valid, valid, tc.Input, errors)
+		}
+	}
+}
+
+func TestValidateJavaComponentName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+		},
+		{
+			Input: "-eureka",
+		},
+		{
+			Input: "eureka-",
+		},
+		{
+			Input: "config--server",
+		},
+		{
+			Input: "Eureka",
+		},
+		{
+			Input: "eureka",
+			Valid: true,
+		},
+		{
+			Input: "config-server-2",
+			Valid: true,
+		},
+		{
+			Input: "a12345678901234567890123456789012",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := JavaComponentName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %s: %+v", tc.Valid, valid, tc.Input, errors)
+		}
+	}
+}
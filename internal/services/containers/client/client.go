@@ -6,6 +6,7 @@ package client
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerinstance/2023-05-01/containerinstance"
 	containerregistry_v2019_06_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-07-01/cacherules"
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-02-01/trustedaccess"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-11-01/extensions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2023-05-01/fluxconfiguration"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -44,6 +46,8 @@ type Client struct {
 	SnapshotClient                              *snapshots.SnapshotsClient
 	TrustedAccessClient                         *trustedaccess.TrustedAccessClient
 	Environment                                 environments.Environment
+
+	authorizerFunc common.ApiAuthorizerFunc
 }
 
 func NewContainersClient(o *common.ClientOptions) (*Client, error) {
@@ -157,5 +161,20 @@ func NewContainersClient(o *common.ClientOptions) (*Client, error) {
 		SnapshotClient:                              snapshotClient,
 		TrustedAccessClient:                         trustedAccessClient,
 		Environment:                                 o.Environment,
+
+		authorizerFunc: o.Authorizers.AuthorizerFunc,
 	}, nil
 }
+
+// KubernetesClusterAADServerAuthorizer returns an Authorizer which obtains tokens for the Entra ID server application
+// used by a Kubernetes Cluster, as specified by the `--server-id` argument of the cluster's `exec` kubeconfig
+func (c *Client) KubernetesClusterAADServerAuthorizer(serverId string) (auth.Authorizer, error) {
+	api := environments.NewApiEndpoint("AzureKubernetesServiceAadServer", serverId, pointer.To(serverId)).WithResourceIdentifier(serverId)
+
+	authorizer, err := c.authorizerFunc(api)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", api.Name(), err)
+	}
+
+	return authorizer, nil
+}
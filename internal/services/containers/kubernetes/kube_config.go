@@ -5,6 +5,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -37,6 +38,7 @@ type userItemAAD struct {
 
 type userAAD struct {
 	AuthProvider authProvider `yaml:"auth-provider"`
+	Exec         *ExecConfig  `yaml:"exec,omitempty"`
 }
 
 type authProvider struct {
@@ -50,6 +52,33 @@ type configAzureAD struct {
 	TenantID    string `yaml:"tenant-id,omitempty"`
 }
 
+// ExecConfig is the credential plugin configuration returned by AKS when the kubeconfig is requested in the `exec` format
+type ExecConfig struct {
+	APIVersion  string    `yaml:"apiVersion"`
+	Command     string    `yaml:"command"`
+	Args        []string  `yaml:"args,omitempty"`
+	Env         []ExecEnv `yaml:"env,omitempty"`
+	InstallHint string    `yaml:"installHint,omitempty"`
+}
+
+type ExecEnv struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// Arg returns the value of the named argument (e.g. `--server-id`) passed to the credential plugin, if present
+func (e ExecConfig) Arg(name string) string {
+	for i, arg := range e.Args {
+		if arg == name && i+1 < len(e.Args) {
+			return e.Args[i+1]
+		}
+		if strings.HasPrefix(arg, name+"=") {
+			return strings.TrimPrefix(arg, name+"=")
+		}
+	}
+	return ""
+}
+
 type contextItem struct {
 	Name    string  `yaml:"name"`
 	Context context `yaml:"context"`
@@ -125,3 +154,22 @@ func ParseKubeConfigAAD(config string) (*KubeConfigAAD, error) {
 
 	return &kubeConfig, nil
 }
+
+// ParseKubeConfigExec parses a kubeconfig which authenticates using a credential exec plugin (such as kubelogin)
+// and returns the exec configuration for the first user
+func ParseKubeConfigExec(config string) (*KubeConfigAAD, *ExecConfig, error) {
+	kubeConfig, err := ParseKubeConfigAAD(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	exec := kubeConfig.Users[0].User.Exec
+	if exec == nil || exec.Command == "" {
+		return nil, nil, fmt.Errorf("Config has no exec credential plugin for user %q", kubeConfig.Users[0].Name)
+	}
+	if exec.Arg("--server-id") == "" {
+		return nil, nil, fmt.Errorf("Config has no `--server-id` argument for the exec credential plugin of user %q", kubeConfig.Users[0].Name)
+	}
+
+	return kubeConfig, exec, nil
+}
@@ -195,3 +195,58 @@ func LoadConfig(fileName string) string {
 
 	return string(bytes)
 }
+
+func TestParseKubeConfigExec(t *testing.T) {
+	testCases := []struct {
+		sourceFile       string
+		expectedServer   string
+		expectedCommand  string
+		expectedServerId string
+		expectError      bool
+	}{
+		{
+			sourceFile:       "user_with_exec.yml",
+			expectedServer:   "https://testcluster.hcp.westeurope.azmk8s.io:443",
+			expectedCommand:  "kubelogin",
+			expectedServerId: "6dae42f8-4368-4678-94ff-3960e28e3630",
+		},
+		{
+			sourceFile:  "user_with_token.yml",
+			expectError: true,
+		},
+		{
+			sourceFile:  "cluster_with_no_server.yml",
+			expectError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Logf("[DEBUG] Testing %q", test.sourceFile)
+
+		encodedConfig := LoadConfig(test.sourceFile)
+		if len(encodedConfig) == 0 {
+			t.Fatalf("failed to read config from file %q", test.sourceFile)
+		}
+
+		config, exec, err := ParseKubeConfigExec(encodedConfig)
+		if err != nil {
+			if test.expectError {
+				continue
+			}
+			t.Fatalf("unexpected error parsing %q: %+v", test.sourceFile, err)
+		}
+		if test.expectError {
+			t.Fatalf("expected an error parsing %q but didn't get one", test.sourceFile)
+		}
+
+		if actual := config.Clusters[0].Cluster.Server; actual != test.expectedServer {
+			t.Fatalf("expected server to be %q but got %q", test.expectedServer, actual)
+		}
+		if exec.Command != test.expectedCommand {
+			t.Fatalf("expected command to be %q but got %q", test.expectedCommand, exec.Command)
+		}
+		if actual := exec.Arg("--server-id"); actual != test.expectedServerId {
+			t.Fatalf("expected server id to be %q but got %q", test.expectedServerId, actual)
+		}
+	}
+}
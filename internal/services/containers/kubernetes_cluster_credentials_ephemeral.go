@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-02-01/managedclusters"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/kubernetes"
+)
+
+const (
+	kubernetesClusterCredentialTypeAdmin   = "Admin"
+	kubernetesClusterCredentialTypeEntraID = "EntraID"
+	kubernetesClusterCredentialTypeUser    = "User"
+
+	kubernetesClusterCredentialsPrivateKey = "kubernetes_cluster_credentials"
+
+	// kubernetesClusterCredentialsRenewBuffer is the amount of time before an Entra ID token expires at which it'll be renewed
+	kubernetesClusterCredentialsRenewBuffer = 5 * time.Minute
+)
+
+var _ sdk.EphemeralResourceWithRenew = &KubernetesClusterCredentialsEphemeralResource{}
+
+func NewKubernetesClusterCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &KubernetesClusterCredentialsEphemeralResource{}
+}
+
+type KubernetesClusterCredentialsEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type KubernetesClusterCredentialsEphemeralResourceModel struct {
+	KubernetesClusterId  types.String `tfsdk:"kubernetes_cluster_id"`
+	CredentialType       types.String `tfsdk:"credential_type"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	Token                types.String `tfsdk:"token"`
+	Username             types.String `tfsdk:"username"`
+	ExpirationDate       types.String `tfsdk:"expiration_date"`
+	KubeConfigRaw        types.String `tfsdk:"kube_config_raw"`
+}
+
+// kubernetesClusterCredentialsPrivateData is persisted in the Private State so that Renew can obtain a new Entra ID token
+type kubernetesClusterCredentialsPrivateData struct {
+	KubernetesClusterId string `json:"kubernetes_cluster_id"`
+	ServerId            string `json:"server_id"`
+}
+
+func (e *KubernetesClusterCredentialsEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_kubernetes_cluster_credentials"
+}
+
+func (e *KubernetesClusterCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *KubernetesClusterCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_cluster_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateKubernetesClusterID,
+					},
+				},
+			},
+
+			"credential_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						kubernetesClusterCredentialTypeAdmin,
+						kubernetesClusterCredentialTypeEntraID,
+						kubernetesClusterCredentialTypeUser,
+					),
+				},
+			},
+
+			"host": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"client_certificate": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"client_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"username": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"expiration_date": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"kube_config_raw": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *KubernetesClusterCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Containers.KubernetesClustersClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data KubernetesClusterCredentialsEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := commonids.ParseKubernetesClusterID(data.KubernetesClusterId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	credentialType := kubernetesClusterCredentialTypeUser
+	if v := data.CredentialType.ValueString(); v != "" {
+		credentialType = v
+	}
+	data.CredentialType = types.StringValue(credentialType)
+
+	var credentials *managedclusters.CredentialResults
+	configName := "clusterUser"
+	switch credentialType {
+	case kubernetesClusterCredentialTypeAdmin:
+		configName = "clusterAdmin"
+		result, err := client.ListClusterAdminCredentials(ctx, *id, managedclusters.ListClusterAdminCredentialsOperationOptions{})
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving Admin Credentials for %s", id), err)
+			return
+		}
+		credentials = result.Model
+
+	case kubernetesClusterCredentialTypeEntraID:
+		options := managedclusters.ListClusterUserCredentialsOperationOptions{
+			Format: pointer.To(managedclusters.FormatExec),
+		}
+		result, err := client.ListClusterUserCredentials(ctx, *id, options)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving User Credentials for %s", id), err)
+			return
+		}
+		credentials = result.Model
+
+	default:
+		result, err := client.ListClusterUserCredentials(ctx, *id, managedclusters.ListClusterUserCredentialsOperationOptions{})
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving User Credentials for %s", id), err)
+			return
+		}
+		credentials = result.Model
+	}
+
+	rawConfig := findKubernetesClusterKubeConfig(credentials, configName)
+	if rawConfig == "" {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s Credentials for %s", credentialType, id), fmt.Sprintf("no kubeconfig named %q was returned", configName))
+		return
+	}
+	data.KubeConfigRaw = types.StringValue(rawConfig)
+
+	if credentialType != kubernetesClusterCredentialTypeEntraID {
+		kubeConfig, err := kubernetes.ParseKubeConfig(rawConfig)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("parsing %s Credentials for %s", credentialType, id), err)
+			return
+		}
+
+		cluster := kubeConfig.Clusters[0].Cluster
+		user := kubeConfig.Users[0]
+		data.Host = types.StringValue(cluster.Server)
+		data.ClusterCACertificate = types.StringValue(cluster.ClusterAuthorityData)
+		data.ClientCertificate = types.StringValue(user.User.ClientCertificteData)
+		data.ClientKey = types.StringValue(user.User.ClientKeyData)
+		data.Token = types.StringValue(user.User.Token)
+		data.Username = types.StringValue(user.Name)
+		data.ExpirationDate = types.StringNull()
+
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	kubeConfig, exec, err := kubernetes.ParseKubeConfigExec(rawConfig)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("parsing Entra ID Credentials for %s - ensure that `azure_active_directory_role_based_access_control` is configured on the Kubernetes Cluster", id), err)
+		return
+	}
+
+	serverId := exec.Arg("--server-id")
+	token, expiresOn, err := e.kubernetesClusterEntraIDToken(ctx, serverId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("obtaining Entra ID token for %s", id), err)
+		return
+	}
+
+	cluster := kubeConfig.Clusters[0].Cluster
+	data.Host = types.StringValue(cluster.Server)
+	data.ClusterCACertificate = types.StringValue(cluster.ClusterAuthorityData)
+	data.ClientCertificate = types.StringNull()
+	data.ClientKey = types.StringNull()
+	data.Token = types.StringValue(token)
+	data.Username = types.StringValue(kubeConfig.Users[0].Name)
+	data.ExpirationDate = types.StringValue(expiresOn.Format(time.RFC3339))
+
+	privateData, err := json.Marshal(kubernetesClusterCredentialsPrivateData{
+		KubernetesClusterId: id.ID(),
+		ServerId:            serverId,
+	})
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "marshalling private data", err)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, kubernetesClusterCredentialsPrivateKey, privateData)...)
+	resp.RenewAt = expiresOn.Add(-kubernetesClusterCredentialsRenewBuffer)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Renew is only scheduled for Entra ID credentials, where it confirms that a new token can still be obtained for the
+// Kubernetes Cluster before the previous one expires, so that long-running operations fail early if access is revoked
+func (e *KubernetesClusterCredentialsEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	raw, diags := req.Private.GetKey(ctx, kubernetesClusterCredentialsPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(raw) == 0 {
+		return
+	}
+
+	var privateData kubernetesClusterCredentialsPrivateData
+	if err := json.Unmarshal(raw, &privateData); err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "unmarshalling private data", err)
+		return
+	}
+
+	_, expiresOn, err := e.kubernetesClusterEntraIDToken(ctx, privateData.ServerId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("renewing Entra ID token for %s", privateData.KubernetesClusterId), err)
+		return
+	}
+
+	resp.RenewAt = expiresOn.Add(-kubernetesClusterCredentialsRenewBuffer)
+}
+
+func (e *KubernetesClusterCredentialsEphemeralResource) kubernetesClusterEntraIDToken(ctx context.Context, serverId string) (string, time.Time, error) {
+	authorizer, err := e.Client.Containers.KubernetesClusterAADServerAuthorizer(serverId)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	token, err := authorizer.Token(ctx, &http.Request{})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("obtaining token for server ID %q: %+v", serverId, err)
+	}
+	if token == nil || token.AccessToken == "" {
+		return "", time.Time{}, fmt.Errorf("obtaining token for server ID %q: token was nil or empty", serverId)
+	}
+
+	return token.AccessToken, token.Expiry, nil
+}
+
+func findKubernetesClusterKubeConfig(model *managedclusters.CredentialResults, configName string) string {
+	if model == nil || model.Kubeconfigs == nil {
+		return ""
+	}
+
+	for _, c := range *model.Kubeconfigs {
+		if !strings.EqualFold(pointer.From(c.Name), configName) || c.Value == nil {
+			continue
+		}
+
+		rawConfig := *c.Value
+		if base64IsEncoded(rawConfig) {
+			rawConfig = base64Decode(rawConfig)
+		}
+		return rawConfig
+	}
+
+	return ""
+}
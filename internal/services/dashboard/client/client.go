@@ -14,6 +14,8 @@ import (
 type Client struct {
 	GrafanaResourceClient         *grafanaresource.GrafanaResourceClient
 	ManagedPrivateEndpointsClient *managedprivateendpoints.ManagedPrivateEndpointsClient
+
+	o *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -32,5 +34,6 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	return &Client{
 		GrafanaResourceClient:         grafanaResourceClient,
 		ManagedPrivateEndpointsClient: managedPrivateEndpointsClient,
+		o:                             o,
 	}, nil
 }
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2023-09-01/grafanaresource"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// grafanaApplicationId is the ID of the Entra ID application used by Azure Managed Grafana, which tokens for the
+// Grafana HTTP API must be issued for
+const grafanaApplicationId = "ce34e7e5-485f-4d76-964f-b3d2b16d1e4f"
+
+// GrafanaClient manages the contents of an Azure Managed Grafana instance using the Grafana HTTP API
+type GrafanaClient struct {
+	Client *dataplane.Client
+}
+
+type GrafanaFolder struct {
+	Uid       string `json:"uid,omitempty"`
+	Title     string `json:"title"`
+	ParentUid string `json:"parentUid,omitempty"`
+	Url       string `json:"url,omitempty"`
+	Version   int64  `json:"version,omitempty"`
+	Overwrite bool   `json:"overwrite,omitempty"`
+}
+
+type GrafanaDashboardInput struct {
+	Dashboard map[string]interface{} `json:"dashboard"`
+	FolderUid string                 `json:"folderUid,omitempty"`
+	Message   string                 `json:"message,omitempty"`
+	Overwrite bool                   `json:"overwrite"`
+}
+
+type GrafanaDashboardSaveResult struct {
+	Uid     string `json:"uid"`
+	Url     string `json:"url"`
+	Version int64  `json:"version"`
+}
+
+type GrafanaDashboard struct {
+	Dashboard map[string]interface{} `json:"dashboard"`
+	Meta      GrafanaDashboardMeta   `json:"meta"`
+}
+
+type GrafanaDashboardMeta struct {
+	FolderUid string `json:"folderUid"`
+	Url       string `json:"url"`
+	Version   int64  `json:"version"`
+}
+
+type GrafanaDataSource struct {
+	Uid              string                 `json:"uid,omitempty"`
+	Name             string                 `json:"name"`
+	Type             string                 `json:"type"`
+	Url              string                 `json:"url"`
+	Access           string                 `json:"access"`
+	IsDefault        bool                   `json:"isDefault"`
+	BasicAuth        bool                   `json:"basicAuth"`
+	BasicAuthUser    string                 `json:"basicAuthUser"`
+	User             string                 `json:"user"`
+	Database         string                 `json:"database"`
+	JsonData         map[string]interface{} `json:"jsonData,omitempty"`
+	SecureJsonData   map[string]string      `json:"secureJsonData,omitempty"`
+	SecureJsonFields map[string]bool        `json:"secureJsonFields,omitempty"`
+}
+
+type grafanaDataSourceResult struct {
+	DataSource GrafanaDataSource `json:"datasource"`
+}
+
+// GrafanaClientWithEndpoint returns a GrafanaClient for the instance with the specified endpoint, authorized using
+// the credentials configured for the Provider
+func (c *Client) GrafanaClientWithEndpoint(endpoint string) (*GrafanaClient, error) {
+	api := environments.NewApiEndpoint("AzureManagedGrafana", endpoint, pointer.To(grafanaApplicationId)).WithResourceIdentifier(grafanaApplicationId)
+
+	authorizer, err := c.o.Authorizers.AuthorizerFunc(api)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", endpoint, err)
+	}
+
+	grafanaClient := dataplane.NewDataPlaneClient(endpoint, "grafana", "v1")
+	c.o.Configure(grafanaClient, authorizer)
+
+	return &GrafanaClient{
+		Client: grafanaClient,
+	}, nil
+}
+
+// GrafanaClientForInstance looks up the endpoint of the specified Azure Managed Grafana instance and returns a
+// GrafanaClient for it
+func (c *Client) GrafanaClientForInstance(ctx context.Context, id grafanaresource.GrafanaId) (*GrafanaClient, error) {
+	resp, err := c.GrafanaResourceClient.GrafanaGet(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	endpoint := ""
+	if model := resp.Model; model != nil && model.Properties != nil {
+		endpoint = pointer.From(model.Properties.Endpoint)
+	}
+	if endpoint == "" {
+		return nil, fmt.Errorf("retrieving %s: `properties.endpoint` was nil", id)
+	}
+
+	return c.GrafanaClientWithEndpoint(endpoint)
+}
+
+func (c GrafanaClient) CreateFolder(ctx context.Context, input GrafanaFolder) (*GrafanaFolder, error) {
+	var result GrafanaFolder
+	if _, err := c.execute(ctx, http.MethodPost, "/api/folders", input, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c GrafanaClient) GetFolder(ctx context.Context, uid string) (*GrafanaFolder, *http.Response, error) {
+	var result GrafanaFolder
+	resp, err := c.execute(ctx, http.MethodGet, fmt.Sprintf("/api/folders/%s", url.PathEscape(uid)), nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (c GrafanaClient) UpdateFolder(ctx context.Context, uid string, input GrafanaFolder) error {
+	_, err := c.execute(ctx, http.MethodPut, fmt.Sprintf("/api/folders/%s", url.PathEscape(uid)), input, nil)
+	return err
+}
+
+func (c GrafanaClient) MoveFolder(ctx context.Context, uid string, parentUid string) error {
+	input := map[string]interface{}{
+		"parentUid": parentUid,
+	}
+	_, err := c.execute(ctx, http.MethodPost, fmt.Sprintf("/api/folders/%s/move", url.PathEscape(uid)), input, nil)
+	return err
+}
+
+func (c GrafanaClient) DeleteFolder(ctx context.Context, uid string) (*http.Response, error) {
+	return c.execute(ctx, http.MethodDelete, fmt.Sprintf("/api/folders/%s", url.PathEscape(uid)), nil, nil)
+}
+
+func (c GrafanaClient) SaveDashboard(ctx context.Context, input GrafanaDashboardInput) (*GrafanaDashboardSaveResult, error) {
+	var result GrafanaDashboardSaveResult
+	if _, err := c.execute(ctx, http.MethodPost, "/api/dashboards/db", input, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c GrafanaClient) GetDashboard(ctx context.Context, uid string) (*GrafanaDashboard, *http.Response, error) {
+	var result GrafanaDashboard
+	resp, err := c.execute(ctx, http.MethodGet, fmt.Sprintf("/api/dashboards/uid/%s", url.PathEscape(uid)), nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (c GrafanaClient) DeleteDashboard(ctx context.Context, uid string) (*http.Response, error) {
+	return c.execute(ctx, http.MethodDelete, fmt.Sprintf("/api/dashboards/uid/%s", url.PathEscape(uid)), nil, nil)
+}
+
+func (c GrafanaClient) CreateDataSource(ctx context.Context, input GrafanaDataSource) (*GrafanaDataSource, error) {
+	var result grafanaDataSourceResult
+	if _, err := c.execute(ctx, http.MethodPost, "/api/datasources", input, &result); err != nil {
+		return nil, err
+	}
+	return &result.DataSource, nil
+}
+
+func (c GrafanaClient) GetDataSource(ctx context.Context, uid string) (*GrafanaDataSource, *http.Response, error) {
+	var result GrafanaDataSource
+	resp, err := c.execute(ctx, http.MethodGet, fmt.Sprintf("/api/datasources/uid/%s", url.PathEscape(uid)), nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (c GrafanaClient) UpdateDataSource(ctx context.Context, uid string, input GrafanaDataSource) error {
+	_, err := c.execute(ctx, http.MethodPut, fmt.Sprintf("/api/datasources/uid/%s", url.PathEscape(uid)), input, nil)
+	return err
+}
+
+func (c GrafanaClient) DeleteDataSource(ctx context.Context, uid string) (*http.Response, error) {
+	return c.execute(ctx, http.MethodDelete, fmt.Sprintf("/api/datasources/uid/%s", url.PathEscape(uid)), nil, nil)
+}
+
+// execute sends the request, returning the HTTP Response so that callers can check whether the item was not found
+func (c GrafanaClient) execute(ctx context.Context, method string, path string, input interface{}, output interface{}) (*http.Response, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: method,
+		Path:       path,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if input != nil {
+		if err := req.Marshal(input); err != nil {
+			return nil, fmt.Errorf("marshaling request: %+v", err)
+		}
+	}
+
+	resp, err := req.Execute(ctx)
+	var httpResp *http.Response
+	if resp != nil {
+		httpResp = resp.Response
+	}
+	if err != nil {
+		return httpResp, err
+	}
+
+	if output != nil {
+		if err := resp.Unmarshal(output); err != nil {
+			return httpResp, fmt.Errorf("unmarshaling response: %+v", err)
+		}
+	}
+
+	return httpResp, nil
+}
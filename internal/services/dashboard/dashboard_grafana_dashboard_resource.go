@@ -0,0 +1,357 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2023-09-01/grafanaresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type GrafanaDashboardResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = GrafanaDashboardResource{}
+	_ sdk.ResourceWithCustomizeDiff = GrafanaDashboardResource{}
+)
+
+type GrafanaDashboardModel struct {
+	GrafanaId  string `tfschema:"grafana_id"`
+	ConfigJson string `tfschema:"config_json"`
+	FolderUid  string `tfschema:"folder_uid"`
+	Message    string `tfschema:"message"`
+	Uid        string `tfschema:"uid"`
+	Url        string `tfschema:"url"`
+	Version    int64  `tfschema:"version"`
+}
+
+func (r GrafanaDashboardResource) ModelObject() interface{} {
+	return &GrafanaDashboardModel{}
+}
+
+func (r GrafanaDashboardResource) ResourceType() string {
+	return "azurerm_dashboard_grafana_dashboard"
+}
+
+func (r GrafanaDashboardResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.GrafanaDashboardId
+}
+
+func (r GrafanaDashboardResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"grafana_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: grafanaresource.ValidateGrafanaID,
+		},
+
+		"config_json": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: suppressGrafanaDashboardJsonDiff,
+		},
+
+		"folder_uid": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.GrafanaUid,
+		},
+
+		"message": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r GrafanaDashboardResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"uid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"version": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r GrafanaDashboardResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model GrafanaDashboardModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			grafanaId, err := grafanaresource.ParseGrafanaID(model.GrafanaId)
+			if err != nil {
+				return err
+			}
+
+			config, err := expandGrafanaDashboardConfig(model.ConfigJson)
+			if err != nil {
+				return err
+			}
+
+			grafanaClient, err := metadata.Client.Dashboard.GrafanaClientForInstance(ctx, *grafanaId)
+			if err != nil {
+				return err
+			}
+
+			if uid, ok := config["uid"].(string); ok && uid != "" {
+				id := parse.NewGrafanaDashboardId(grafanaId.SubscriptionId, grafanaId.ResourceGroupName, grafanaId.GrafanaName, uid)
+				_, resp, err := grafanaClient.GetDashboard(ctx, uid)
+				if err != nil && !response.WasNotFound(resp) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				if !response.WasNotFound(resp) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			input := client.GrafanaDashboardInput{
+				Dashboard: config,
+				FolderUid: model.FolderUid,
+				Message:   model.Message,
+			}
+
+			result, err := grafanaClient.SaveDashboard(ctx, input)
+			if err != nil {
+				return fmt.Errorf("creating Grafana Dashboard in %s: %+v", *grafanaId, err)
+			}
+
+			id := parse.NewGrafanaDashboardId(grafanaId.SubscriptionId, grafanaId.ResourceGroupName, grafanaId.GrafanaName, result.Uid)
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r GrafanaDashboardResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.GrafanaDashboardID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			grafanaId := grafanaresource.NewGrafanaID(id.SubscriptionId, id.ResourceGroupName, id.GrafanaName)
+			grafanaClient, err := metadata.Client.Dashboard.GrafanaClientForInstance(ctx, grafanaId)
+			if err != nil {
+				return err
+			}
+
+			dashboard, resp, err := grafanaClient.GetDashboard(ctx, id.DashboardUid)
+			if err != nil {
+				if response.WasNotFound(resp) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := GrafanaDashboardModel{
+				GrafanaId: grafanaId.ID(),
+				FolderUid: dashboard.Meta.FolderUid,
+				Uid:       id.DashboardUid,
+				Url:       dashboard.Meta.Url,
+				Version:   dashboard.Meta.Version,
+			}
+
+			// `message` is only used when saving a new version of the Dashboard and so isn't returned by the API
+			if v, ok := metadata.ResourceData.GetOk("message"); ok {
+				state.Message = v.(string)
+			}
+
+			// the `uid` is only retained when it's specified in the configuration, since otherwise it's generated by Grafana
+			retainUid := false
+			if existing, err := expandGrafanaDashboardConfig(metadata.ResourceData.Get("config_json").(string)); err == nil {
+				_, retainUid = existing["uid"]
+			}
+			configJson, err := flattenGrafanaDashboardConfig(dashboard.Dashboard, retainUid)
+			if err != nil {
+				return fmt.Errorf("flattening `config_json`: %+v", err)
+			}
+			state.ConfigJson = configJson
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r GrafanaDashboardResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.GrafanaDashboardID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model GrafanaDashboardModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			config, err := expandGrafanaDashboardConfig(model.ConfigJson)
+			if err != nil {
+				return err
+			}
+
+			// the `uid` is set explicitly so that the existing Dashboard is overwritten when it was generated by Grafana
+			config["uid"] = id.DashboardUid
+
+			grafanaId := grafanaresource.NewGrafanaID(id.SubscriptionId, id.ResourceGroupName, id.GrafanaName)
+			grafanaClient, err := metadata.Client.Dashboard.GrafanaClientForInstance(ctx, grafanaId)
+			if err != nil {
+				return err
+			}
+
+			input := client.GrafanaDashboardInput{
+				Dashboard: config,
+				FolderUid: model.FolderUid,
+				Message:   model.Message,
+				Overwrite: true,
+			}
+
+			if _, err := grafanaClient.SaveDashboard(ctx, input); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GrafanaDashboardResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.GrafanaDashboardID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			grafanaId := grafanaresource.NewGrafanaID(id.SubscriptionId, id.ResourceGroupName, id.GrafanaName)
+			grafanaClient, err := metadata.Client.Dashboard.GrafanaClientForInstance(ctx, grafanaId)
+			if err != nil {
+				return err
+			}
+
+			if resp, err := grafanaClient.DeleteDashboard(ctx, id.DashboardUid); err != nil && !response.WasNotFound(resp) {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GrafanaDashboardResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+			if rd.Id() == "" || !rd.HasChange("config_json") {
+				return nil
+			}
+
+			id, err := parse.GrafanaDashboardID(rd.Id())
+			if err != nil {
+				return err
+			}
+
+			// the Dashboard has to be recreated when the `uid` within the configuration changes, since it identifies the Dashboard
+			config, err := expandGrafanaDashboardConfig(rd.Get("config_json").(string))
+			if err != nil {
+				// the value may not be known until apply
+				return nil
+			}
+			if uid, ok := config["uid"].(string); ok && uid != "" && uid != id.DashboardUid {
+				return rd.ForceNew("config_json")
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandGrafanaDashboardConfig(input string) (map[string]interface{}, error) {
+	config := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(input), &config); err != nil {
+		return nil, fmt.Errorf("parsing `config_json`: %+v", err)
+	}
+
+	// the `id` is specific to the Grafana instance that the Dashboard was exported from, and would otherwise cause the
+	// Dashboard to be matched to an existing Dashboard rather than by the `uid`
+	delete(config, "id")
+
+	return config, nil
+}
+
+// flattenGrafanaDashboardConfig removes the fields which are managed by Grafana, and so change each time that the
+// Dashboard is saved, so that they don't show as a diff
+func flattenGrafanaDashboardConfig(input map[string]interface{}, retainUid bool) (string, error) {
+	output := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		output[k] = v
+	}
+	delete(output, "id")
+	delete(output, "version")
+	if !retainUid {
+		delete(output, "uid")
+	}
+
+	b, err := json.Marshal(output)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func suppressGrafanaDashboardJsonDiff(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	oldConfig, err := expandGrafanaDashboardConfig(old)
+	if err != nil {
+		return false
+	}
+	newConfig, err := expandGrafanaDashboardConfig(new)
+	if err != nil {
+		return false
+	}
+
+	_, retainUid := newConfig["uid"]
+	oldJson, err := flattenGrafanaDashboardConfig(oldConfig, retainUid)
+	if err != nil {
+		return false
+	}
+	newJson, err := flattenGrafanaDashboardConfig(newConfig, retainUid)
+	if err != nil {
+		return false
+	}
+
+	return oldJson == newJson
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dashboard_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2023-09-01/grafanaresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type GrafanaDashboardResource struct{}
+
+func TestAccDashboardGrafanaDashboard_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana_dashboard", "test")
+	r := GrafanaDashboardResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("uid").Exists(),
+				check.That(data.ResourceName).Key("version").HasValue("1"),
+			),
+		},
+		data.ImportStep("message"),
+	})
+}
+
+func TestAccDashboardGrafanaDashboard_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana_dashboard", "test")
+	r := GrafanaDashboardResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDashboardGrafanaDashboard_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana_dashboard", "test")
+	r := GrafanaDashboardResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("message"),
+	})
+}
+
+func TestAccDashboardGrafanaDashboard_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana_dashboard", "test")
+	r := GrafanaDashboardResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version").HasValue("1"),
+			),
+		},
+		data.ImportStep("message"),
+		{
+			Config: r.completeUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version").HasValue("2"),
+			),
+		},
+		data.ImportStep("message"),
+	})
+}
+
+func (r GrafanaDashboardResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.GrafanaDashboardID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	grafanaId := grafanaresource.NewGrafanaID(id.SubscriptionId, id.ResourceGroupName, id.GrafanaName)
+	grafanaClient, err := clients.Dashboard.GrafanaClientForInstance(ctx, grafanaId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, resp, err := grafanaClient.GetDashboard(ctx, id.DashboardUid)
+	if err != nil {
+		if response.WasNotFound(resp) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r GrafanaDashboardResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-rg-%d"
+  location = "%s"
+}
+
+resource "azurerm_dashboard_grafana" "test" {
+  name                  = "a-dg-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  grafana_major_version = "11"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_dashboard_grafana.test.id
+  role_definition_name = "Grafana Admin"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r GrafanaDashboardResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dashboard_grafana_dashboard" "test" {
+  grafana_id = azurerm_dashboard_grafana.test.id
+  config_json = jsonencode({
+    title = "acctest-dashboard-%d"
+  })
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GrafanaDashboardResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dashboard_grafana_dashboard" "import" {
+  grafana_id  = azurerm_dashboard_grafana_dashboard.test.grafana_id
+  config_json = azurerm_dashboard_grafana_dashboard.test.config_json
+}
+`, r.complete(data))
+}
+
+func (r GrafanaDashboardResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dashboard_grafana_folder" "test" {
+  grafana_id = azurerm_dashboard_grafana.test.id
+  title      = "acctest-folder-%d"
+
+  depends_on = [azurerm_role_assignment.test]
+}
+
+resource "azurerm_dashboard_grafana_dashboard" "test" {
+  grafana_id = azurerm_dashboard_grafana.test.id
+  folder_uid = azurerm_dashboard_grafana_folder.test.uid
+  message    = "Created by Terraform"
+  config_json = jsonencode({
+    id    = 12
+    uid   = "acctest-%d"
+    title = "acctest-dashboard-%d"
+    tags  = ["acctest"]
+    panels = [
+      {
+        id    = 1
+        type  = "text"
+        title = "Notes"
+        gridPos = {
+          h = 4
+          w = 12
+          x = 0
+          y = 0
+        }
+      },
+    ]
+  })
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r GrafanaDashboardResource) completeUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dashboard_grafana_folder" "test" {
+  grafana_id = azurerm_dashboard_grafana.test.id
+  title      = "acctest-folder-%d"
+
+  depends_on = [azurerm_role_assignment.test]
+}
+
+resource "azurerm_dashboard_grafana_dashboard" "test" {
+  grafana_id = azurerm_dashboard_grafana.test.id
+  folder_uid = azurerm_dashboard_grafana_folder.test.uid
+  message    = "Updated by Terraform"
+  config_json = jsonencode({
+    id     = 12
+    uid    = "acctest-%d"
+    title  = "acctest-dashboard-updated-%d"
+    tags   = ["acctest", "updated"]
+    panels = []
+  })
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2023-09-01/grafanaresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type GrafanaDataSourceResource struct{}
+
+var _ sdk.ResourceWithUpdate = GrafanaDataSourceResource{}
+
+type GrafanaDataSourceModel struct {
+	GrafanaId             string `tfschema:"grafana_id"`
+	Name                  string `tfschema:"name"`
+	Type                  string `tfschema:"type"`
+	Uid                   string `tfschema:"uid"`
+	Url                   string `tfschema:"url"`
+	AccessMode            string `tfschema:"access_mode"`
+	DefaultEnabled        bool   `tfschema:"default_enabled"`
+	DatabaseName          string `tfschema:"database_name"`
+	Username              string `tfschema:"username"`
+	BasicAuthEnabled      bool   `tfschema:"basic_auth_enabled"`
+	BasicAuthUsername     string `tfschema:"basic_auth_username"`
+	JsonDataEncoded       string `tfschema:"json_data_encoded"`
+	SecureJsonDataEncoded string `tfschema:"secure_json_data_encoded"`
+}
+
+func (r GrafanaDataSourceResource) ModelObject() interface{} {
+	return &GrafanaDataSourceModel{}
+}
+
+func (r GrafanaDataSourceResource) ResourceType() string {
+	return "azurerm_dashboard_grafana_data_source"
+}
+
+func (r GrafanaDataSourceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.GrafanaDataSourceId
+}
+
+func (r GrafanaDataSourceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"grafana_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: grafanaresource.ValidateGrafanaID,
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"uid": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.GrafanaUid,
+		},
+
+		"url": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"access_mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "proxy",
+			ValidateFunc: validation.StringInSlice([]string{
+				"direct",
+				"proxy",
+			}, false),
+		},
+
+		"default_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"database_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"username": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"basic_auth_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"basic_auth_username": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"basic_auth_enabled"},
+		},
+
+		"json_data_encoded": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		// the values of the secure settings are never returned by Grafana, so changes made outside of Terraform can't be detected
+		"secure_json_data_encoded": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsJSON,
+		},
+	}
+}
+
+func (r GrafanaDataSourceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r GrafanaDataSourceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model GrafanaDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			grafanaId, err := grafanaresource.ParseGrafanaID(model.GrafanaId)
+			if err != nil {
+				return err
+			}
+
+			grafanaClient, err := metadata.Client.Dashboard.GrafanaClientForInstance(ctx, *grafanaId)
+			if err != nil {
+				return err
+			}
+
+			if model.Uid != "" {
+				id := parse.NewGrafanaDataSourceId(grafanaId.SubscriptionId, grafanaId.ResourceGroupName, grafanaId.GrafanaName, model.Uid)
+				_, resp, err := grafanaClient.GetDataSource(ctx, model.Uid)
+				if err != nil && !response.WasNotFound(resp) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				if !response.WasNotFound(resp) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			input, err := expandGrafanaDataSource(model, true)
+			if err != nil {
+				return err
+			}
+
+			result, err := grafanaClient.CreateDataSource(ctx, *input)
+			if err != nil {
+				return fmt.Errorf("creating Grafana Data Source %q in %s: %+v", model.Name, *grafanaId, err)
+			}
+
+			id := parse.NewGrafanaDataSourceId(grafanaId.SubscriptionId, grafanaId.ResourceGroupName, grafanaId.GrafanaName, result.Uid)
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r GrafanaDataSourceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.GrafanaDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			grafanaId := grafanaresource.NewGrafanaID(id.SubscriptionId, id.ResourceGroupName, id.GrafanaName)
+			grafanaClient, err := metadata.Client.Dashboard.GrafanaClientForInstance(ctx, grafanaId)
+			if err != nil {
+				return err
+			}
+
+			dataSource, resp, err := grafanaClient.GetDataSource(ctx, id.DataSourceUid)
+			if err != nil {
+				if response.WasNotFound(resp) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := GrafanaDataSourceModel{
+				GrafanaId:         grafanaId.ID(),
+				Uid:               id.DataSourceUid,
+				Name:              dataSource.Name,
+				Type:              dataSource.Type,
+				Url:               dataSource.Url,
+				AccessMode:        dataSource.Access,
+				DefaultEnabled:    dataSource.IsDefault,
+				DatabaseName:      dataSource.Database,
+				Username:          dataSource.User,
+				BasicAuthEnabled:  dataSource.BasicAuth,
+				BasicAuthUsername: dataSource.BasicAuthUser,
+			}
+
+			if len(dataSource.JsonData) > 0 {
+				jsonData, err := json.Marshal(dataSource.JsonData)
+				if err != nil {
+					return fmt.Errorf("marshaling `json_data_encoded`: %+v", err)
+				}
+				state.JsonDataEncoded = string(jsonData)
+			}
+
+			if v, ok := metadata.ResourceData.GetOk("secure_json_data_encoded"); ok {
+				state.SecureJsonDataEncoded = v.(string)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r GrafanaDataSourceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.GrafanaDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model GrafanaDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the existing secure settings are retained by Grafana when they're omitted from the request
+			input, err := expandGrafanaDataSource(model, metadata.ResourceData.HasChange("secure_json_data_encoded"))
+			if err != nil {
+				return err
+			}
+			input.Uid = id.DataSourceUid
+
+			grafanaId := grafanaresource.NewGrafanaID(id.SubscriptionId, id.ResourceGroupName, id.GrafanaName)
+			grafanaClient, err := metadata.Client.Dashboard.GrafanaClientForInstance(ctx, grafanaId)
+			if err != nil {
+				return err
+			}
+
+			if err := grafanaClient.UpdateDataSource(ctx, id.DataSourceUid, *input); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GrafanaDataSourceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.GrafanaDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			grafanaId := grafanaresource.NewGrafanaID(id.SubscriptionId, id.ResourceGroupName, id.GrafanaName)
+			grafanaClient, err := metadata.Client.Dashboard.GrafanaClientForInstance(ctx, grafanaId)
+			if err != nil {
+				return err
+			}
+
+			if resp, err := grafanaClient.DeleteDataSource(ctx, id.DataSourceUid); err != nil && !response.WasNotFound(resp) {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandGrafanaDataSource(input GrafanaDataSourceModel, includeSecureJsonData bool) (*client.GrafanaDataSource, error) {
+	output := client.GrafanaDataSource{
+		Uid:           input.Uid,
+		Name:          input.Name,
+		Type:          input.Type,
+		Url:           input.Url,
+		Access:        input.AccessMode,
+		IsDefault:     input.DefaultEnabled,
+		Database:      input.DatabaseName,
+		User:          input.Username,
+		BasicAuth:     input.BasicAuthEnabled,
+		BasicAuthUser: input.BasicAuthUsername,
+	}
+
+	if input.JsonDataEncoded != "" {
+		if err := json.Unmarshal([]byte(input.JsonDataEncoded), &output.JsonData); err != nil {
+			return nil, fmt.Errorf("parsing `json_data_encoded`: %+v", err)
+		}
+	}
+
+	if includeSecureJsonData && input.SecureJsonDataEncoded != "" {
+		if err := json.Unmarshal([]byte(input.SecureJsonDataEncoded), &output.SecureJsonData); err != nil {
+			return nil, fmt.Errorf("parsing `secure_json_data_encoded`: %+v", err)
+		}
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dashboard_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2023-09-01/grafanaresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type GrafanaDataSourceResource struct{}
+
+func TestAccDashboardGrafanaDataSourceResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana_data_source", "test")
+	r := GrafanaDataSourceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDashboardGrafanaDataSourceResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana_data_source", "test")
+	r := GrafanaDataSourceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDashboardGrafanaDataSourceResource_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana_data_source", "test")
+	r := GrafanaDataSourceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("secure_json_data_encoded"),
+	})
+}
+
+func TestAccDashboardGrafanaDataSourceResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana_data_source", "test")
+	r := GrafanaDataSourceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("secure_json_data_encoded"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r GrafanaDataSourceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.GrafanaDataSourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	grafanaId := grafanaresource.NewGrafanaID(id.SubscriptionId, id.ResourceGroupName, id.GrafanaName)
+	grafanaClient, err := clients.Dashboard.GrafanaClientForInstance(ctx, grafanaId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, resp, err := grafanaClient.GetDataSource(ctx, id.DataSourceUid)
+	if err != nil {
+		if response.WasNotFound(resp) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r GrafanaDataSourceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-rg-%d"
+  location = "%s"
+}
+
+resource "azurerm_dashboard_grafana" "test" {
+  name                  = "a-dg-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  grafana_major_version = "11"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_dashboard_grafana.test.id
+  role_definition_name = "Grafana Admin"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r GrafanaDataSourceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dashboard_grafana_data_source" "test" {
+  grafana_id = azurerm_dashboard_grafana.test.id
+  name       = "acctest-ds-%d"
+  type       = "prometheus"
+  url        = "https://prometheus.example.com"
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GrafanaDataSourceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dashboard_grafana_data_source" "import" {
+  grafana_id = azurerm_dashboard_grafana_data_source.test.grafana_id
+  name       = azurerm_dashboard_grafana_data_source.test.name
+  type       = azurerm_dashboard_grafana_data_source.test.type
+  uid        = azurerm_dashboard_grafana_data_source.test.uid
+  url        = azurerm_dashboard_grafana_data_source.test.url
+}
+`, r.basic(data))
+}
+
+func (r GrafanaDataSourceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dashboard_grafana_data_source" "test" {
+  grafana_id          = azurerm_dashboard_grafana.test.id
+  name                = "acctest-ds-updated-%d"
+  type                = "prometheus"
+  url                 = "https://prometheus-updated.example.com"
+  access_mode         = "proxy"
+  default_enabled     = true
+  basic_auth_enabled  = true
+  basic_auth_username = "acctest"
+
+  json_data_encoded = jsonencode({
+    httpMethod   = "POST"
+    timeInterval = "30s"
+  })
+
+  secure_json_data_encoded = jsonencode({
+    basicAuthPassword = "P@ssw0rd1234!"
+  })
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2023-09-01/grafanaresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type GrafanaFolderResource struct{}
+
+var _ sdk.ResourceWithUpdate = GrafanaFolderResource{}
+
+type GrafanaFolderModel struct {
+	GrafanaId       string `tfschema:"grafana_id"`
+	Title           string `tfschema:"title"`
+	Uid             string `tfschema:"uid"`
+	ParentFolderUid string `tfschema:"parent_folder_uid"`
+	Url             string `tfschema:"url"`
+}
+
+func (r GrafanaFolderResource) ModelObject() interface{} {
+	return &GrafanaFolderModel{}
+}
+
+func (r GrafanaFolderResource) ResourceType() string {
+	return "azurerm_dashboard_grafana_folder"
+}
+
+func (r GrafanaFolderResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.GrafanaFolderId
+}
+
+func (r GrafanaFolderResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"grafana_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: grafanaresource.ValidateGrafanaID,
+		},
+
+		"title": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"uid": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.GrafanaUid,
+		},
+
+		"parent_folder_uid": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.GrafanaUid,
+		},
+	}
+}
+
+func (r GrafanaFolderResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r GrafanaFolderResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model GrafanaFolderModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			grafanaId, err := grafanaresource.ParseGrafanaID(model.GrafanaId)
+			if err != nil {
+				return err
+			}
+
+			grafanaClient, err := metadata.Client.Dashboard.GrafanaClientForInstance(ctx, *grafanaId)
+			if err != nil {
+				return err
+			}
+
+			if model.Uid != "" {
+				id := parse.NewGrafanaFolderId(grafanaId.SubscriptionId, grafanaId.ResourceGroupName, grafanaId.GrafanaName, model.Uid)
+				_, resp, err := grafanaClient.GetFolder(ctx, model.Uid)
+				if err != nil && !response.WasNotFound(resp) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				if !response.WasNotFound(resp) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			input := client.GrafanaFolder{
+				Uid:       model.Uid,
+				Title:     model.Title,
+				ParentUid: model.ParentFolderUid,
+			}
+
+			result, err := grafanaClient.CreateFolder(ctx, input)
+			if err != nil {
+				return fmt.Errorf("creating Grafana Folder %q in %s: %+v", model.Title, *grafanaId, err)
+			}
+
+			id := parse.NewGrafanaFolderId(grafanaId.SubscriptionId, grafanaId.ResourceGroupName, grafanaId.GrafanaName, result.Uid)
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r GrafanaFolderResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.GrafanaFolderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			grafanaId := grafanaresource.NewGrafanaID(id.SubscriptionId, id.ResourceGroupName, id.GrafanaName)
+			grafanaClient, err := metadata.Client.Dashboard.GrafanaClientForInstance(ctx, grafanaId)
+			if err != nil {
+				return err
+			}
+
+			folder, resp, err := grafanaClient.GetFolder(ctx, id.FolderUid)
+			if err != nil {
+				if response.WasNotFound(resp) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := GrafanaFolderModel{
+				GrafanaId:       grafanaId.ID(),
+				Uid:             id.FolderUid,
+				Title:           folder.Title,
+				ParentFolderUid: folder.ParentUid,
+				Url:             folder.Url,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r GrafanaFolderResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.GrafanaFolderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model GrafanaFolderModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			grafanaId := grafanaresource.NewGrafanaID(id.SubscriptionId, id.ResourceGroupName, id.GrafanaName)
+			grafanaClient, err := metadata.Client.Dashboard.GrafanaClientForInstance(ctx, grafanaId)
+			if err != nil {
+				return err
+			}
+
+			// the parent of a Folder can only be changed using the Move API
+			if metadata.ResourceData.HasChange("parent_folder_uid") {
+				if err := grafanaClient.MoveFolder(ctx, id.FolderUid, model.ParentFolderUid); err != nil {
+					return fmt.Errorf("moving %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("title") {
+				input := client.GrafanaFolder{
+					Title:     model.Title,
+					Overwrite: true,
+				}
+				if err := grafanaClient.UpdateFolder(ctx, id.FolderUid, input); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GrafanaFolderResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.GrafanaFolderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			grafanaId := grafanaresource.NewGrafanaID(id.SubscriptionId, id.ResourceGroupName, id.GrafanaName)
+			grafanaClient, err := metadata.Client.Dashboard.GrafanaClientForInstance(ctx, grafanaId)
+			if err != nil {
+				return err
+			}
+
+			if resp, err := grafanaClient.DeleteFolder(ctx, id.FolderUid); err != nil && !response.WasNotFound(resp) {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
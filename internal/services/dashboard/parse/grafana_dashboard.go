@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &GrafanaDashboardId{}
+
+// GrafanaDashboardId is a struct representing the Resource ID for a Grafana Dashboard within an Azure Managed Grafana instance
+type GrafanaDashboardId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	GrafanaName       string
+	DashboardUid      string
+}
+
+// NewGrafanaDashboardId returns a new GrafanaDashboardId struct
+func NewGrafanaDashboardId(subscriptionId string, resourceGroupName string, grafanaName string, dashboardUid string) GrafanaDashboardId {
+	return GrafanaDashboardId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		GrafanaName:       grafanaName,
+		DashboardUid:      dashboardUid,
+	}
+}
+
+// GrafanaDashboardID parses 'input' into a GrafanaDashboardId
+func GrafanaDashboardID(input string) (*GrafanaDashboardId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&GrafanaDashboardId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := GrafanaDashboardId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// GrafanaDashboardIDInsensitively parses 'input' case-insensitively into a GrafanaDashboardId
+// note: this method should only be used for API response data and not user input
+func GrafanaDashboardIDInsensitively(input string) (*GrafanaDashboardId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&GrafanaDashboardId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := GrafanaDashboardId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *GrafanaDashboardId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.GrafanaName, ok = input.Parsed["grafanaName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "grafanaName", input)
+	}
+
+	if id.DashboardUid, ok = input.Parsed["dashboardUid"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "dashboardUid", input)
+	}
+
+	return nil
+}
+
+// ID returns the formatted Grafana Dashboard ID
+func (id GrafanaDashboardId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Dashboard/grafana/%s/dashboards/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.GrafanaName, id.DashboardUid)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Grafana Dashboard ID
+func (id GrafanaDashboardId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDashboard", "Microsoft.Dashboard", "Microsoft.Dashboard"),
+		resourceids.StaticSegment("staticGrafana", "grafana", "grafana"),
+		resourceids.UserSpecifiedSegment("grafanaName", "grafanaValue"),
+		resourceids.StaticSegment("staticDashboards", "dashboards", "dashboards"),
+		resourceids.UserSpecifiedSegment("dashboardUid", "dashboardUidValue"),
+	}
+}
+
+// String returns a human-readable description of this Grafana Dashboard ID
+func (id GrafanaDashboardId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Grafana Name: %q", id.GrafanaName),
+		fmt.Sprintf("Dashboard Uid: %q", id.DashboardUid),
+	}
+	return fmt.Sprintf("Grafana Dashboard (%s)", strings.Join(components, "\n"))
+}
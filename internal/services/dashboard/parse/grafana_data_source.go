@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &GrafanaDataSourceId{}
+
+// GrafanaDataSourceId is a struct representing the Resource ID for a Grafana Data Source within an Azure Managed Grafana instance
+type GrafanaDataSourceId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	GrafanaName       string
+	DataSourceUid     string
+}
+
+// NewGrafanaDataSourceId returns a new GrafanaDataSourceId struct
+func NewGrafanaDataSourceId(subscriptionId string, resourceGroupName string, grafanaName string, dataSourceUid string) GrafanaDataSourceId {
+	return GrafanaDataSourceId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		GrafanaName:       grafanaName,
+		DataSourceUid:     dataSourceUid,
+	}
+}
+
+// GrafanaDataSourceID parses 'input' into a GrafanaDataSourceId
+func GrafanaDataSourceID(input string) (*GrafanaDataSourceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&GrafanaDataSourceId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := GrafanaDataSourceId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// GrafanaDataSourceIDInsensitively parses 'input' case-insensitively into a GrafanaDataSourceId
+// note: this method should only be used for API response data and not user input
+func GrafanaDataSourceIDInsensitively(input string) (*GrafanaDataSourceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&GrafanaDataSourceId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := GrafanaDataSourceId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *GrafanaDataSourceId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.GrafanaName, ok = input.Parsed["grafanaName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "grafanaName", input)
+	}
+
+	if id.DataSourceUid, ok = input.Parsed["dataSourceUid"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "dataSourceUid", input)
+	}
+
+	return nil
+}
+
+// ID returns the formatted Grafana Data Source ID
+func (id GrafanaDataSourceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Dashboard/grafana/%s/dataSources/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.GrafanaName, id.DataSourceUid)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Grafana Data Source ID
+func (id GrafanaDataSourceId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDashboard", "Microsoft.Dashboard", "Microsoft.Dashboard"),
+		resourceids.StaticSegment("staticGrafana", "grafana", "grafana"),
+		resourceids.UserSpecifiedSegment("grafanaName", "grafanaValue"),
+		resourceids.StaticSegment("staticDataSources", "dataSources", "dataSources"),
+		resourceids.UserSpecifiedSegment("dataSourceUid", "dataSourceUidValue"),
+	}
+}
+
+// String returns a human-readable description of this Grafana Data Source ID
+func (id GrafanaDataSourceId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Grafana Name: %q", id.GrafanaName),
+		fmt.Sprintf("Data Source Uid: %q", id.DataSourceUid),
+	}
+	return fmt.Sprintf("Grafana Data Source (%s)", strings.Join(components, "\n"))
+}
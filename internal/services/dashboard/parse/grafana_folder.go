@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &GrafanaFolderId{}
+
+// GrafanaFolderId is a struct representing the Resource ID for a Grafana Folder within an Azure Managed Grafana instance
+type GrafanaFolderId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	GrafanaName       string
+	FolderUid         string
+}
+
+// NewGrafanaFolderId returns a new GrafanaFolderId struct
+func NewGrafanaFolderId(subscriptionId string, resourceGroupName string, grafanaName string, folderUid string) GrafanaFolderId {
+	return GrafanaFolderId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		GrafanaName:       grafanaName,
+		FolderUid:         folderUid,
+	}
+}
+
+// GrafanaFolderID parses 'input' into a GrafanaFolderId
+func GrafanaFolderID(input string) (*GrafanaFolderId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&GrafanaFolderId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := GrafanaFolderId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// GrafanaFolderIDInsensitively parses 'input' case-insensitively into a GrafanaFolderId
+// note: this method should only be used for API response data and not user input
+func GrafanaFolderIDInsensitively(input string) (*GrafanaFolderId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&GrafanaFolderId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := GrafanaFolderId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *GrafanaFolderId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.GrafanaName, ok = input.Parsed["grafanaName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "grafanaName", input)
+	}
+
+	if id.FolderUid, ok = input.Parsed["folderUid"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "folderUid", input)
+	}
+
+	return nil
+}
+
+// ID returns the formatted Grafana Folder ID
+func (id GrafanaFolderId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Dashboard/grafana/%s/folders/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.GrafanaName, id.FolderUid)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Grafana Folder ID
+func (id GrafanaFolderId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDashboard", "Microsoft.Dashboard", "Microsoft.Dashboard"),
+		resourceids.StaticSegment("staticGrafana", "grafana", "grafana"),
+		resourceids.UserSpecifiedSegment("grafanaName", "grafanaValue"),
+		resourceids.StaticSegment("staticFolders", "folders", "folders"),
+		resourceids.UserSpecifiedSegment("folderUid", "folderUidValue"),
+	}
+}
+
+// String returns a human-readable description of this Grafana Folder ID
+func (id GrafanaFolderId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Grafana Name: %q", id.GrafanaName),
+		fmt.Sprintf("Folder Uid: %q", id.FolderUid),
+	}
+	return fmt.Sprintf("Grafana Folder (%s)", strings.Join(components, "\n"))
+}
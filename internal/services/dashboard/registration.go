@@ -52,6 +52,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		DashboardGrafanaResource{},
+		GrafanaDashboardResource{},
+		GrafanaDataSourceResource{},
+		GrafanaFolderResource{},
 		ManagedPrivateEndpointResource{},
 	}
 }
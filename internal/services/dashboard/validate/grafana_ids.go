@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/parse"
+)
+
+// GrafanaFolderId checks that 'input' can be parsed as a Grafana Folder ID
+func GrafanaFolderId(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := parse.GrafanaFolderID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// GrafanaDashboardId checks that 'input' can be parsed as a Grafana Dashboard ID
+func GrafanaDashboardId(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := parse.GrafanaDashboardID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// GrafanaDataSourceId checks that 'input' can be parsed as a Grafana Data Source ID
+func GrafanaDataSourceId(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := parse.GrafanaDataSourceID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
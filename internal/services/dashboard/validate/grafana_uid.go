@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// GrafanaUid checks that 'input' is a valid identifier for a Grafana Folder, Dashboard or Data Source
+func GrafanaUid(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9\-_]{1,40}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 40 characters long and can only contain alphanumeric characters, dashes and underscores, got %q", key, v))
+	}
+
+	return
+}
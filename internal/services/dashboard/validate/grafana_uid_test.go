@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestGrafanaUid(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "abc",
+			Valid: true,
+		},
+		{
+			Input: "my-folder_01",
+			Valid: true,
+		},
+		{
+			Input: "my folder",
+			Valid: false,
+		},
+		{
+			Input: "my/folder",
+			Valid: false,
+		},
+		{
+			Input: "a123456789012345678901234567890123456789",
+			Valid: true,
+		},
+		{
+			Input: "a1234567890123456789012345678901234567890",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := GrafanaUid(tc.Input, "uid")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
 	featureWorkspaces "github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2023-09-01/deletedworkspaces"
+	tables20250201 "github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2025-02-01/tables"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationsmanagement/2015-11-01-preview/solution"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -37,7 +38,10 @@ type Client struct {
 	QueryPackQueriesClient     *querypackqueries.QueryPackQueriesClient
 	SharedKeyWorkspacesClient  *workspaces.WorkspacesClient
 	TablesClient               *tables.TablesClient
+	Tables20250201Client       *tables20250201.TablesClient        // 2022-10-01 API version does not support the Auxiliary plan, which is required for custom tables
 	WorkspaceClient            *featureWorkspaces.WorkspacesClient // 2022-10-01 API version does not contain sharedkeys related API, so we keep two versions SDK of this API
+
+	o *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -125,6 +129,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(tablesClient.Client, o.Authorizers.ResourceManager)
 
+	tables20250201Client, err := tables20250201.NewTablesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Tables client: %+v", err)
+	}
+	o.Configure(tables20250201Client.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		ClusterClient:              clusterClient,
 		DataExportClient:           dataExportClient,
@@ -139,6 +149,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		StorageInsightsClient:      storageInsightsClient,
 		SharedKeyWorkspacesClient:  workspacesClient,
 		TablesClient:               tablesClient,
+		Tables20250201Client:       tables20250201Client,
 		WorkspaceClient:            featureWorkspaceClient,
+		o:                          o,
 	}, nil
 }
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+)
+
+// QueryClient runs KQL queries using the Log Analytics Query API, which is a data plane API and so requires a token
+// for the Operational Insights endpoint rather than Resource Manager
+type QueryClient struct {
+	Client *dataplane.Client
+}
+
+type QueryBody struct {
+	Query    string  `json:"query"`
+	Timespan *string `json:"timespan,omitempty"`
+}
+
+type QueryResults struct {
+	Tables []QueryTable `json:"tables"`
+	Error  *QueryError  `json:"error,omitempty"`
+}
+
+type QueryTable struct {
+	Name    string              `json:"name"`
+	Columns []QueryColumn       `json:"columns"`
+	Rows    [][]json.RawMessage `json:"rows"`
+}
+
+type QueryColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type QueryError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewQueryClient returns a QueryClient authorized using the credentials configured for the Provider
+func (c *Client) NewQueryClient() (*QueryClient, error) {
+	api := c.o.Environment.OperationalInsights
+	if api == nil || !api.Available() {
+		return nil, fmt.Errorf("the Log Analytics Query API is not available in the %q environment", c.o.Environment.Name)
+	}
+
+	endpoint, ok := api.ResourceIdentifier()
+	if !ok {
+		return nil, fmt.Errorf("determining the endpoint of the Log Analytics Query API")
+	}
+
+	authorizer, err := c.o.Authorizers.AuthorizerFunc(api)
+	if err != nil {
+		return nil, fmt.Errorf("building Authorizer for the Log Analytics Query API: %+v", err)
+	}
+
+	queryClient := dataplane.NewDataPlaneClient(*endpoint, "loganalyticsquery", "v1")
+	c.o.Configure(queryClient, authorizer)
+
+	return &QueryClient{
+		Client: queryClient,
+	}, nil
+}
+
+// Query runs the query against the workspace with the specified Customer ID, waiting up to `waitInSeconds` for the
+// query to complete on the server
+func (c QueryClient) Query(ctx context.Context, workspaceCustomerId string, input QueryBody, waitInSeconds int64) (*QueryResults, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPost,
+		Path:       fmt.Sprintf("/v1/workspaces/%s/query", workspaceCustomerId),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if waitInSeconds > 0 {
+		req.Header.Set("Prefer", fmt.Sprintf("wait=%d", waitInSeconds))
+	}
+
+	if err := req.Marshal(input); err != nil {
+		return nil, fmt.Errorf("marshaling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result QueryResults
+	if err := resp.Unmarshal(&result); err != nil {
+		return nil, fmt.Errorf("unmarshaling response: %+v", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type LogAnalyticsQueryDataSource struct{}
+
+var _ sdk.DataSource = LogAnalyticsQueryDataSource{}
+
+type LogAnalyticsQueryDataSourceModel struct {
+	WorkspaceId string                         `tfschema:"workspace_id"`
+	Query       string                         `tfschema:"query"`
+	Timespan    string                         `tfschema:"timespan"`
+	RowLimit    int64                          `tfschema:"row_limit"`
+	Column      []LogAnalyticsQueryColumnModel `tfschema:"column"`
+	RowCount    int64                          `tfschema:"row_count"`
+	RowsJson    string                         `tfschema:"rows_json"`
+	Truncated   bool                           `tfschema:"truncated"`
+}
+
+type LogAnalyticsQueryColumnModel struct {
+	Name string `tfschema:"name"`
+	Type string `tfschema:"type"`
+}
+
+func (LogAnalyticsQueryDataSource) ResourceType() string {
+	return "azurerm_log_analytics_query"
+}
+
+func (LogAnalyticsQueryDataSource) ModelObject() interface{} {
+	return &LogAnalyticsQueryDataSourceModel{}
+}
+
+func (LogAnalyticsQueryDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"timespan": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		// the API returns at most 500000 rows, one more row than the limit is requested to detect truncation
+		"row_limit": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1000,
+			ValidateFunc: validation.IntBetween(1, 499999),
+		},
+	}
+}
+
+func (LogAnalyticsQueryDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"column": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"row_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"rows_json": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"truncated": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func (LogAnalyticsQueryDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			workspacesClient := metadata.Client.LogAnalytics.WorkspaceClient
+
+			var state LogAnalyticsQueryDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(state.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			// the Query API identifies the workspace by its Customer ID rather than the Resource ID
+			workspace, err := workspacesClient.Get(ctx, *workspaceId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *workspaceId, err)
+			}
+			customerId := ""
+			if model := workspace.Model; model != nil && model.Properties != nil {
+				customerId = pointer.From(model.Properties.CustomerId)
+			}
+			if customerId == "" {
+				return fmt.Errorf("retrieving %s: `properties.customerId` was nil", *workspaceId)
+			}
+
+			queryClient, err := metadata.Client.LogAnalytics.NewQueryClient()
+			if err != nil {
+				return err
+			}
+
+			// one more row than the limit is requested to determine whether the results have been truncated
+			input := client.QueryBody{
+				Query: fmt.Sprintf("%s\n| take %d", strings.TrimRight(strings.TrimSpace(state.Query), ";"), state.RowLimit+1),
+			}
+			if state.Timespan != "" {
+				input.Timespan = pointer.To(state.Timespan)
+			}
+
+			// the server stops waiting for the query shortly before the read timeout so that the error is returned by the API
+			wait := int64(0)
+			if deadline, ok := ctx.Deadline(); ok {
+				wait = int64(time.Until(deadline).Seconds()) - 5
+				if wait > 600 {
+					wait = 600
+				}
+			}
+
+			result, err := queryClient.Query(ctx, customerId, input, wait)
+			if err != nil {
+				return fmt.Errorf("querying %s: %+v", *workspaceId, err)
+			}
+
+			// partial results are returned alongside an error when the query exceeds the limits of the service, which
+			// would otherwise lead to assertions being made on incomplete data
+			if result.Error != nil {
+				return fmt.Errorf("querying %s: %s: %s", *workspaceId, result.Error.Code, result.Error.Message)
+			}
+
+			if len(result.Tables) == 0 {
+				return fmt.Errorf("querying %s: no results were returned", *workspaceId)
+			}
+
+			table := result.Tables[0]
+			state.Column = make([]LogAnalyticsQueryColumnModel, 0, len(table.Columns))
+			for _, v := range table.Columns {
+				state.Column = append(state.Column, LogAnalyticsQueryColumnModel{
+					Name: v.Name,
+					Type: v.Type,
+				})
+			}
+
+			rows := table.Rows
+			state.Truncated = int64(len(rows)) > state.RowLimit
+			if state.Truncated {
+				rows = rows[:state.RowLimit]
+			}
+			state.RowCount = int64(len(rows))
+
+			rowsJson, err := flattenLogAnalyticsQueryRows(table.Columns, rows)
+			if err != nil {
+				return fmt.Errorf("flattening the results of querying %s: %+v", *workspaceId, err)
+			}
+			state.RowsJson = rowsJson
+
+			metadata.SetID(workspaceId)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// flattenLogAnalyticsQueryRows returns the rows as a JSON array of objects keyed by the column name, retaining the type of
+// each value so that numbers and booleans don't need to be converted after decoding
+func flattenLogAnalyticsQueryRows(columns []client.QueryColumn, rows [][]json.RawMessage) (string, error) {
+	output := make([]map[string]json.RawMessage, 0, len(rows))
+	for _, row := range rows {
+		if len(row) != len(columns) {
+			return "", fmt.Errorf("expected %d values in each row but got %d", len(columns), len(row))
+		}
+
+		item := make(map[string]json.RawMessage, len(columns))
+		for i, column := range columns {
+			value := row[i]
+
+			// `dynamic` values are returned as a JSON encoded string
+			if strings.EqualFold(column.Type, "dynamic") {
+				var encoded string
+				if err := json.Unmarshal(value, &encoded); err == nil && json.Valid([]byte(encoded)) {
+					value = json.RawMessage(encoded)
+				}
+			}
+
+			item[column.Name] = value
+		}
+		output = append(output, item)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(output); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
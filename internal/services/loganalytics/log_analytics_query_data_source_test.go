@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type LogAnalyticsQueryDataSource struct{}
+
+func TestAccDataSourceLogAnalyticsQuery_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_log_analytics_query", "test")
+	r := LogAnalyticsQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("row_count").HasValue("1"),
+				check.That(data.ResourceName).Key("truncated").HasValue("false"),
+				check.That(data.ResourceName).Key("column.#").HasValue("3"),
+				check.That(data.ResourceName).Key("column.0.name").HasValue("Message"),
+				check.That(data.ResourceName).Key("column.0.type").HasValue("string"),
+				check.That(data.ResourceName).Key("column.1.type").HasValue("long"),
+				check.That(data.ResourceName).Key("column.2.type").HasValue("dynamic"),
+				check.That(data.ResourceName).Key("rows_json").HasValue(`[{"Count":1,"Message":"hello","Properties":{"enabled":true}}]`),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceLogAnalyticsQuery_rowLimit(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_log_analytics_query", "test")
+	r := LogAnalyticsQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.rowLimit(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("row_count").HasValue("3"),
+				check.That(data.ResourceName).Key("truncated").HasValue("true"),
+				check.That(data.ResourceName).Key("column.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rows_json").HasValue(`[{"Value":1},{"Value":2},{"Value":3}]`),
+			),
+		},
+	})
+}
+
+func (LogAnalyticsQueryDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-la-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r LogAnalyticsQueryDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_log_analytics_query" "test" {
+  workspace_id = azurerm_log_analytics_workspace.test.id
+  query        = "print Message = \"hello\", Count = 1, Properties = dynamic({\"enabled\": true})"
+  timespan     = "PT1H"
+}
+`, r.template(data))
+}
+
+func (r LogAnalyticsQueryDataSource) rowLimit(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_log_analytics_query" "test" {
+  workspace_id = azurerm_log_analytics_workspace.test.id
+  query        = "range Value from 1 to 10 step 1"
+  row_limit    = 3
+}
+`, r.template(data))
+}
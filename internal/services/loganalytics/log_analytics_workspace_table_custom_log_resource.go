@@ -0,0 +1,447 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2025-02-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// the Auxiliary plan isn't defined in the SDK yet, but is supported by the 2025-02-01 API version
+const logAnalyticsWorkspaceTablePlanAuxiliary = "Auxiliary"
+
+type LogAnalyticsWorkspaceTableCustomLogResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = LogAnalyticsWorkspaceTableCustomLogResource{}
+	_ sdk.ResourceWithCustomizeDiff = LogAnalyticsWorkspaceTableCustomLogResource{}
+)
+
+type LogAnalyticsWorkspaceTableCustomLogResourceModel struct {
+	Name                 string                                  `tfschema:"name"`
+	WorkspaceId          string                                  `tfschema:"workspace_id"`
+	Column               []LogAnalyticsWorkspaceTableColumnModel `tfschema:"column"`
+	Description          string                                  `tfschema:"description"`
+	Plan                 string                                  `tfschema:"plan"`
+	RetentionInDays      int64                                   `tfschema:"retention_in_days"`
+	TotalRetentionInDays int64                                   `tfschema:"total_retention_in_days"`
+	StandardColumn       []LogAnalyticsWorkspaceTableColumnModel `tfschema:"standard_column"`
+}
+
+type LogAnalyticsWorkspaceTableColumnModel struct {
+	Name        string `tfschema:"name"`
+	Type        string `tfschema:"type"`
+	Description string `tfschema:"description"`
+	DisplayName string `tfschema:"display_name"`
+	TypeHint    string `tfschema:"type_hint"`
+}
+
+func (r LogAnalyticsWorkspaceTableCustomLogResource) ResourceType() string {
+	return "azurerm_log_analytics_workspace_table_custom_log"
+}
+
+func (r LogAnalyticsWorkspaceTableCustomLogResource) ModelObject() interface{} {
+	return &LogAnalyticsWorkspaceTableCustomLogResourceModel{}
+}
+
+func (r LogAnalyticsWorkspaceTableCustomLogResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return tables.ValidateTableID
+}
+
+func (r LogAnalyticsWorkspaceTableCustomLogResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.LogAnalyticsWorkspaceTableCustomLogName,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: tables.ValidateWorkspaceID,
+		},
+
+		"column": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringMatch(
+							regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,44}$`),
+							"`name` must start with a letter, can only contain letters, numbers and underscores and must be at most 45 characters long",
+						),
+					},
+
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(tables.PossibleValuesForColumnTypeEnum(), false),
+					},
+
+					"description": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"display_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type_hint": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(tables.PossibleValuesForColumnDataTypeHintEnum(), false),
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"plan": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(tables.TablePlanEnumAnalytics),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(tables.TablePlanEnumAnalytics),
+				string(tables.TablePlanEnumBasic),
+				logAnalyticsWorkspaceTablePlanAuxiliary,
+			}, false),
+		},
+
+		"retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(4, 730),
+		},
+
+		"total_retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.Any(validation.IntBetween(4, 730), validation.IntInSlice([]int{1095, 1460, 1826, 2191, 2556, 2922, 3288, 3653, 4018, 4383})),
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableCustomLogResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"standard_column": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type_hint": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableCustomLogResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			plan := rd.Get("plan").(string)
+			// `retention_in_days` is computed so the raw config is checked to determine whether it has been set
+			if config := rd.GetRawConfig(); plan != string(tables.TablePlanEnumAnalytics) && config.IsKnown() && !config.IsNull() {
+				if v := config.GetAttr("retention_in_days"); !v.IsNull() {
+					return fmt.Errorf("`retention_in_days` cannot be set when `plan` is `%s` since the interactive retention is fixed", plan)
+				}
+			}
+
+			// the plan of an Auxiliary table is fixed once created
+			if rd.Id() != "" && rd.HasChange("plan") {
+				oldPlan, newPlan := rd.GetChange("plan")
+				if oldPlan.(string) == logAnalyticsWorkspaceTablePlanAuxiliary || newPlan.(string) == logAnalyticsWorkspaceTablePlanAuxiliary {
+					if err := rd.ForceNew("plan"); err != nil {
+						return err
+					}
+				}
+			}
+
+			if !rd.NewValueKnown("column") {
+				return nil
+			}
+
+			newColumns := make(map[string]string)
+			for _, raw := range rd.Get("column").([]interface{}) {
+				column, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name := column["name"].(string)
+				if name == "" {
+					// the name is unknown until apply
+					return nil
+				}
+				if _, exists := newColumns[name]; exists {
+					return fmt.Errorf("the column %q is defined more than once", name)
+				}
+				newColumns[name] = column["type"].(string)
+			}
+
+			if columnType, ok := newColumns["TimeGenerated"]; !ok || columnType != string(tables.ColumnTypeEnumDateTime) {
+				return fmt.Errorf("a `column` named `TimeGenerated` with the type `%s` must be specified", tables.ColumnTypeEnumDateTime)
+			}
+
+			// columns can be added to an existing table in-place, however removing a column or changing its type
+			// requires the table to be recreated
+			if rd.Id() != "" && rd.HasChange("column") {
+				oldColumns, _ := rd.GetChange("column")
+				for _, raw := range oldColumns.([]interface{}) {
+					column, ok := raw.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					if columnType, exists := newColumns[column["name"].(string)]; !exists || columnType != column["type"].(string) {
+						return rd.ForceNew("column")
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableCustomLogResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.Tables20250201Client
+
+			var model LogAnalyticsWorkspaceTableCustomLogResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := tables.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := tables.NewTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandLogAnalyticsWorkspaceTableCustomLog(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableCustomLogResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.Tables20250201Client
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := LogAnalyticsWorkspaceTableCustomLogResourceModel{
+				Name:        id.TableName,
+				WorkspaceId: tables.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Plan = string(pointer.From(props.Plan))
+					if state.Plan == string(tables.TablePlanEnumAnalytics) {
+						state.RetentionInDays = pointer.From(props.RetentionInDays)
+					}
+					state.TotalRetentionInDays = pointer.From(props.TotalRetentionInDays)
+
+					if schema := props.Schema; schema != nil {
+						state.Description = pointer.From(schema.Description)
+						state.Column = flattenLogAnalyticsWorkspaceTableColumns(schema.Columns)
+						state.StandardColumn = flattenLogAnalyticsWorkspaceTableColumns(schema.StandardColumns)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableCustomLogResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.Tables20250201Client
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LogAnalyticsWorkspaceTableCustomLogResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the full schema is sent on each update, the API adds any new columns without affecting the existing data
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandLogAnalyticsWorkspaceTableCustomLog(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableCustomLogResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.Tables20250201Client
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandLogAnalyticsWorkspaceTableCustomLog(model LogAnalyticsWorkspaceTableCustomLogResourceModel) tables.Table {
+	columns := make([]tables.Column, 0, len(model.Column))
+	for _, v := range model.Column {
+		column := tables.Column{
+			Name: pointer.To(v.Name),
+			Type: pointer.To(tables.ColumnTypeEnum(v.Type)),
+		}
+		if v.Description != "" {
+			column.Description = pointer.To(v.Description)
+		}
+		if v.DisplayName != "" {
+			column.DisplayName = pointer.To(v.DisplayName)
+		}
+		if v.TypeHint != "" {
+			column.DataTypeHint = pointer.To(tables.ColumnDataTypeHintEnum(v.TypeHint))
+		}
+		columns = append(columns, column)
+	}
+
+	props := tables.TableProperties{
+		Plan: pointer.To(tables.TablePlanEnum(model.Plan)),
+		Schema: &tables.Schema{
+			Name:    pointer.To(model.Name),
+			Columns: &columns,
+		},
+	}
+
+	if model.Description != "" {
+		props.Schema.Description = pointer.To(model.Description)
+	}
+
+	if model.Plan == string(tables.TablePlanEnumAnalytics) && model.RetentionInDays != 0 {
+		props.RetentionInDays = pointer.To(model.RetentionInDays)
+	}
+
+	if model.TotalRetentionInDays != 0 {
+		props.TotalRetentionInDays = pointer.To(model.TotalRetentionInDays)
+	}
+
+	return tables.Table{
+		Properties: &props,
+	}
+}
+
+func flattenLogAnalyticsWorkspaceTableColumns(input *[]tables.Column) []LogAnalyticsWorkspaceTableColumnModel {
+	output := make([]LogAnalyticsWorkspaceTableColumnModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, LogAnalyticsWorkspaceTableColumnModel{
+			Name:        pointer.From(v.Name),
+			Type:        string(pointer.From(v.Type)),
+			Description: pointer.From(v.Description),
+			DisplayName: pointer.From(v.DisplayName),
+			TypeHint:    string(pointer.From(v.DataTypeHint)),
+		})
+	}
+
+	return output
+}
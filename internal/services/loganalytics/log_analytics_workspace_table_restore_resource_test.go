@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2025-02-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LogAnalyticsWorkspaceTableRestoreResource struct{}
+
+func TestAccLogAnalyticsWorkspaceTableRestore_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_table_restore", "test")
+	r := LogAnalyticsWorkspaceTableRestoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LogAnalyticsWorkspaceTableRestoreResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := tables.ParseTableID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LogAnalytics.Tables20250201Client.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r LogAnalyticsWorkspaceTableRestoreResource) basic(data acceptance.TestData) string {
+	endTime := time.Now().UTC().Truncate(time.Hour)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table_restore" "test" {
+  name              = "acctest%d_RST"
+  workspace_id      = azurerm_log_analytics_workspace.test.id
+  source_table_name = azurerm_log_analytics_workspace_table_custom_log.test.name
+  start_time        = "%s"
+  end_time          = "%s"
+}
+`, LogAnalyticsWorkspaceTableCustomLogResource{}.basic(data), data.RandomInteger, endTime.Add(-24*time.Hour).Format(time.RFC3339), endTime.Format(time.RFC3339))
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2025-02-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LogAnalyticsWorkspaceTableSearchJobResource struct{}
+
+func TestAccLogAnalyticsWorkspaceTableSearchJob_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_table_search_job", "test")
+	r := LogAnalyticsWorkspaceTableSearchJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_table_name").HasValue(fmt.Sprintf("acctest%d_CL", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceTableSearchJob_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_table_search_job", "test")
+	r := LogAnalyticsWorkspaceTableSearchJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LogAnalyticsWorkspaceTableSearchJobResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := tables.ParseTableID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LogAnalytics.Tables20250201Client.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r LogAnalyticsWorkspaceTableSearchJobResource) basic(data acceptance.TestData) string {
+	endTime := time.Now().UTC().Truncate(time.Hour)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table_search_job" "test" {
+  name         = "acctest%d_SRCH"
+  workspace_id = azurerm_log_analytics_workspace.test.id
+  query        = "${azurerm_log_analytics_workspace_table_custom_log.test.name} | where Message has 'error'"
+  start_time   = "%s"
+  end_time     = "%s"
+}
+`, LogAnalyticsWorkspaceTableCustomLogResource{}.basic(data), data.RandomInteger, endTime.Add(-24*time.Hour).Format(time.RFC3339), endTime.Format(time.RFC3339))
+}
+
+func (r LogAnalyticsWorkspaceTableSearchJobResource) complete(data acceptance.TestData) string {
+	endTime := time.Now().UTC().Truncate(time.Hour)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table_search_job" "test" {
+  name         = "acctest%d_SRCH"
+  workspace_id = azurerm_log_analytics_workspace.test.id
+  query        = "${azurerm_log_analytics_workspace_table_custom_log.test.name} | where Message has 'error'"
+  start_time   = "%s"
+  end_time     = "%s"
+  description  = "Acceptance test search job"
+  limit        = 1000
+}
+`, LogAnalyticsWorkspaceTableCustomLogResource{}.basic(data), data.RandomInteger, endTime.Add(-24*time.Hour).Format(time.RFC3339), endTime.Format(time.RFC3339))
+}
@@ -20,7 +20,9 @@ func (r Registration) AssociatedGitHubLabel() string {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		LogAnalyticsQueryDataSource{},
+	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
@@ -30,6 +32,9 @@ func (r Registration) Resources() []sdk.Resource {
 		LogAnalyticsQueryPackQueryResource{},
 		LogAnalyticsSolutionResource{},
 		LogAnalyticsWorkspaceTableResource{},
+		LogAnalyticsWorkspaceTableCustomLogResource{},
+		LogAnalyticsWorkspaceTableRestoreResource{},
+		LogAnalyticsWorkspaceTableSearchJobResource{},
 	}
 }
 
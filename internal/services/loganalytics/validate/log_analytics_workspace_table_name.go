@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func LogAnalyticsWorkspaceTableCustomLogName(i interface{}, k string) (warnings []string, errors []error) {
+	return logAnalyticsWorkspaceTableName(i, k, "_CL")
+}
+
+func LogAnalyticsWorkspaceTableSearchJobName(i interface{}, k string) (warnings []string, errors []error) {
+	return logAnalyticsWorkspaceTableName(i, k, "_SRCH")
+}
+
+func LogAnalyticsWorkspaceTableRestoreName(i interface{}, k string) (warnings []string, errors []error) {
+	return logAnalyticsWorkspaceTableName(i, k, "_RST")
+}
+
+func logAnalyticsWorkspaceTableName(i interface{}, k string, suffix string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !strings.HasSuffix(v, suffix) {
+		errors = append(errors, fmt.Errorf("%q must end with %q, got %q", k, suffix, v))
+		return
+	}
+
+	if len(v) > 63 {
+		errors = append(errors, fmt.Errorf("%q must be at most 63 characters long, got %d", k, len(v)))
+		return
+	}
+
+	if !regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`).MatchString(strings.TrimSuffix(v, suffix)) {
+		errors = append(errors, fmt.Errorf("%q must start with a letter and can only contain letters, numbers and underscores, got %q", k, v))
+	}
+
+	return
+}
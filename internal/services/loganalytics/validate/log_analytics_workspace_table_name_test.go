@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLogAnalyticsWorkspaceTableCustomLogName(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected bool
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Name:     "Missing suffix",
+			Input:    "MyTable",
+			Expected: false,
+		},
+		{
+			Name:     "Lower case suffix",
+			Input:    "MyTable_cl",
+			Expected: false,
+		},
+		{
+			Name:     "Suffix only",
+			Input:    "_CL",
+			Expected: false,
+		},
+		{
+			Name:     "Starts with a number",
+			Input:    "1MyTable_CL",
+			Expected: false,
+		},
+		{
+			Name:     "Invalid characters hyphen",
+			Input:    "My-Table_CL",
+			Expected: false,
+		},
+		{
+			Name:     "Too long",
+			Input:    strings.Repeat("a", 61) + "_CL",
+			Expected: false,
+		},
+		{
+			Name:     "Valid name",
+			Input:    "MyTable_CL",
+			Expected: true,
+		},
+		{
+			Name:     "Valid name with underscores and numbers",
+			Input:    "My_Table_2_CL",
+			Expected: true,
+		},
+		{
+			Name:     "Valid name max length",
+			Input:    strings.Repeat("a", 60) + "_CL",
+			Expected: true,
+		},
+	}
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		_, errors := LogAnalyticsWorkspaceTableCustomLogName(v.Input, "name")
+		result := len(errors) == 0
+		if result != v.Expected {
+			t.Fatalf("Expected the result to be %v but got %v (and %d errors)", v.Expected, result, len(errors))
+		}
+	}
+}
+
+func TestLogAnalyticsWorkspaceTableSearchJobName(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected bool
+	}{
+		{
+			Name:     "Custom log suffix",
+			Input:    "MyTable_CL",
+			Expected: false,
+		},
+		{
+			Name:     "Valid name",
+			Input:    "MyTable_SRCH",
+			Expected: true,
+		},
+	}
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		_, errors := LogAnalyticsWorkspaceTableSearchJobName(v.Input, "name")
+		result := len(errors) == 0
+		if result != v.Expected {
+			t.Fatalf("Expected the result to be %v but got %v (and %d errors)", v.Expected, result, len(errors))
+		}
+	}
+}
+
+func TestLogAnalyticsWorkspaceTableRestoreName(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected bool
+	}{
+		{
+			Name:     "Search job suffix",
+			Input:    "MyTable_SRCH",
+			Expected: false,
+		},
+		{
+			Name:     "Valid name",
+			Input:    "MyTable_RST",
+			Expected: true,
+		},
+	}
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		_, errors := LogAnalyticsWorkspaceTableRestoreName(v.Input, "name")
+		result := len(errors) == 0
+		if result != v.Expected {
+			t.Fatalf("Expected the result to be %v but got %v (and %d errors)", v.Expected, result, len(errors))
+		}
+	}
+}
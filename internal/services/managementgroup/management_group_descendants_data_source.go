@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementgroup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managementgroups/2020-05-01/managementgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceManagementGroupDescendants() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceManagementGroupDescendantsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"management_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ManagementGroupID,
+			},
+
+			"management_group": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"parent_management_group_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"depth": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"subscription": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"subscription_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"parent_management_group_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"depth": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type managementGroupDescendant struct {
+	id                      string
+	name                    string
+	displayName             string
+	parentManagementGroupId string
+	isSubscription          bool
+	depth                   int
+}
+
+func dataSourceManagementGroupDescendantsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.GroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	managementGroupId, err := parse.ManagementGroupID(d.Get("management_group_id").(string))
+	if err != nil {
+		return err
+	}
+	id := commonids.NewManagementGroupID(managementGroupId.Name)
+
+	resp, err := client.GetDescendantsComplete(ctx, id, managementgroups.DefaultGetDescendantsOperationOptions())
+	if err != nil {
+		if response.WasForbidden(resp.LatestHttpResponse) || response.WasNotFound(resp.LatestHttpResponse) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving the descendants of %s: %+v", id, err)
+	}
+
+	descendants := flattenManagementGroupDescendants(id, resp.Items)
+
+	managementGroups := make([]interface{}, 0)
+	subscriptions := make([]interface{}, 0)
+	for _, v := range descendants {
+		if v.isSubscription {
+			subscriptions = append(subscriptions, map[string]interface{}{
+				"id":                         v.id,
+				"subscription_id":            v.name,
+				"display_name":               v.displayName,
+				"parent_management_group_id": v.parentManagementGroupId,
+				"depth":                      v.depth,
+			})
+			continue
+		}
+
+		managementGroups = append(managementGroups, map[string]interface{}{
+			"id":                         v.id,
+			"name":                       v.name,
+			"display_name":               v.displayName,
+			"parent_management_group_id": v.parentManagementGroupId,
+			"depth":                      v.depth,
+		})
+	}
+
+	d.SetId(id.ID())
+	d.Set("management_group_id", managementGroupId.ID())
+
+	if err := d.Set("management_group", managementGroups); err != nil {
+		return fmt.Errorf("setting `management_group`: %+v", err)
+	}
+	if err := d.Set("subscription", subscriptions); err != nil {
+		return fmt.Errorf("setting `subscription`: %+v", err)
+	}
+
+	return nil
+}
+
+// flattenManagementGroupDescendants normalises the IDs of the descendants and calculates their depth below the root,
+// ordering them by depth so that parents always precede their children
+func flattenManagementGroupDescendants(root commonids.ManagementGroupId, input []managementgroups.DescendantInfo) []managementGroupDescendant {
+	descendants := make([]managementGroupDescendant, 0, len(input))
+	parents := make(map[string]string)
+
+	for _, item := range input {
+		rawId := pointer.From(item.Id)
+		if rawId == "" {
+			continue
+		}
+
+		descendant := managementGroupDescendant{
+			id:   rawId,
+			name: pointer.From(item.Name),
+		}
+
+		if subscriptionId, err := commonids.ParseSubscriptionIDInsensitively(rawId); err == nil {
+			descendant.id = subscriptionId.ID()
+			descendant.name = subscriptionId.SubscriptionId
+			descendant.isSubscription = true
+		} else if managementGroupId, err := commonids.ParseManagementGroupIDInsensitively(rawId); err == nil {
+			descendant.id = parse.NewManagementGroupId(managementGroupId.GroupId).ID()
+			descendant.name = managementGroupId.GroupId
+		}
+
+		if props := item.Properties; props != nil {
+			descendant.displayName = pointer.From(props.DisplayName)
+			if props.Parent != nil && props.Parent.Id != nil {
+				if parentId, err := commonids.ParseManagementGroupIDInsensitively(*props.Parent.Id); err == nil {
+					descendant.parentManagementGroupId = parse.NewManagementGroupId(parentId.GroupId).ID()
+				} else {
+					descendant.parentManagementGroupId = *props.Parent.Id
+				}
+			}
+		}
+
+		parents[strings.ToLower(descendant.id)] = strings.ToLower(descendant.parentManagementGroupId)
+		descendants = append(descendants, descendant)
+	}
+
+	rootId := strings.ToLower(parse.NewManagementGroupId(root.GroupId).ID())
+	for i, v := range descendants {
+		depth := 1
+		parent := parents[strings.ToLower(v.id)]
+		// the number of descendants bounds the depth, which guards against a malformed response containing a cycle
+		for parent != "" && parent != rootId && depth <= len(descendants) {
+			next, ok := parents[parent]
+			if !ok {
+				break
+			}
+			parent = next
+			depth++
+		}
+		descendants[i].depth = depth
+	}
+
+	sort.SliceStable(descendants, func(i, j int) bool {
+		if descendants[i].depth != descendants[j].depth {
+			return descendants[i].depth < descendants[j].depth
+		}
+		return strings.ToLower(descendants[i].id) < strings.ToLower(descendants[j].id)
+	})
+
+	return descendants
+}
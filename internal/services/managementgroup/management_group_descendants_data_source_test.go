@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementgroup_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ManagementGroupDescendantsDataSource struct{}
+
+func TestAccManagementGroupDescendantsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_management_group_descendants", "test")
+	r := ManagementGroupDescendantsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("management_group.#").HasValue("2"),
+				check.That(data.ResourceName).Key("management_group.0.display_name").HasValue(fmt.Sprintf("acctestmg-child-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("management_group.0.depth").HasValue("1"),
+				check.That(data.ResourceName).Key("management_group.1.display_name").HasValue(fmt.Sprintf("acctestmg-grandchild-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("management_group.1.depth").HasValue("2"),
+				check.That(data.ResourceName).Key("subscription.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (ManagementGroupDescendantsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "parent" {
+  display_name = "acctestmg-parent-%[1]d"
+}
+
+resource "azurerm_management_group" "child" {
+  display_name               = "acctestmg-child-%[1]d"
+  parent_management_group_id = azurerm_management_group.parent.id
+}
+
+resource "azurerm_management_group" "grandchild" {
+  display_name               = "acctestmg-grandchild-%[1]d"
+  parent_management_group_id = azurerm_management_group.child.id
+}
+
+data "azurerm_management_group_descendants" "test" {
+  management_group_id = azurerm_management_group.parent.id
+
+  depends_on = [azurerm_management_group.grandchild]
+}
+`, data.RandomInteger)
+}
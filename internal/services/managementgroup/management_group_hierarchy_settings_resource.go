@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementgroup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managementgroups/2020-05-01/managementgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceManagementGroupHierarchySettings() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceManagementGroupHierarchySettingsCreate,
+		Read:   resourceManagementGroupHierarchySettingsRead,
+		Update: resourceManagementGroupHierarchySettingsUpdate,
+		Delete: resourceManagementGroupHierarchySettingsDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := commonids.ParseManagementGroupID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"management_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagementGroupID,
+			},
+
+			"default_management_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ManagementGroupID,
+			},
+
+			"require_authorization_for_group_creation": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceManagementGroupHierarchySettingsCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.GroupsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	managementGroupId, err := parse.ManagementGroupID(d.Get("management_group_id").(string))
+	if err != nil {
+		return err
+	}
+	id := commonids.NewManagementGroupID(managementGroupId.Name)
+
+	existing, err := client.HierarchySettingsGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for the presence of existing Hierarchy Settings for %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_management_group_hierarchy_settings", id.ID())
+	}
+
+	if _, err := client.HierarchySettingsCreateOrUpdate(ctx, id, expandManagementGroupHierarchySettings(d)); err != nil {
+		return fmt.Errorf("creating Hierarchy Settings for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceManagementGroupHierarchySettingsRead(d, meta)
+}
+
+func resourceManagementGroupHierarchySettingsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.GroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commonids.ParseManagementGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.HierarchySettingsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Hierarchy Settings for %s: %+v", *id, err)
+	}
+
+	d.Set("management_group_id", parse.NewManagementGroupId(id.GroupId).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			defaultManagementGroupId := ""
+			if v := pointer.From(props.DefaultManagementGroup); v != "" {
+				// the API returns either the ID or the name of the default Management Group
+				if parsed, err := parse.ManagementGroupID(v); err == nil {
+					defaultManagementGroupId = parsed.ID()
+				} else {
+					defaultManagementGroupId = parse.NewManagementGroupId(v).ID()
+				}
+			}
+			d.Set("default_management_group_id", defaultManagementGroupId)
+			d.Set("require_authorization_for_group_creation", pointer.From(props.RequireAuthorizationForGroupCreation))
+		}
+	}
+
+	return nil
+}
+
+func resourceManagementGroupHierarchySettingsUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.GroupsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commonids.ParseManagementGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.HierarchySettingsCreateOrUpdate(ctx, *id, expandManagementGroupHierarchySettings(d)); err != nil {
+		return fmt.Errorf("updating Hierarchy Settings for %s: %+v", *id, err)
+	}
+
+	return resourceManagementGroupHierarchySettingsRead(d, meta)
+}
+
+func resourceManagementGroupHierarchySettingsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.GroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commonids.ParseManagementGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.HierarchySettingsDelete(ctx, *id); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting Hierarchy Settings for %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandManagementGroupHierarchySettings(d *pluginsdk.ResourceData) managementgroups.CreateOrUpdateSettingsRequest {
+	props := managementgroups.CreateOrUpdateSettingsProperties{
+		RequireAuthorizationForGroupCreation: pointer.To(d.Get("require_authorization_for_group_creation").(bool)),
+	}
+
+	// new Subscriptions are placed in the root Management Group when no default is specified
+	if v := d.Get("default_management_group_id").(string); v != "" {
+		props.DefaultManagementGroup = pointer.To(v)
+	}
+
+	return managementgroups.CreateOrUpdateSettingsRequest{
+		Properties: &props,
+	}
+}
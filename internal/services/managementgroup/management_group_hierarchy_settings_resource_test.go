@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementgroup_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagementGroupHierarchySettingsResource struct{}
+
+// the Hierarchy Settings are a singleton for the tenant, so these tests can't run in parallel
+func TestAccManagementGroupHierarchySettings(t *testing.T) {
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"hierarchySettings": {
+			"basic":          testAccManagementGroupHierarchySettings_basic,
+			"update":         testAccManagementGroupHierarchySettings_update,
+			"requiresImport": testAccManagementGroupHierarchySettings_requiresImport,
+		},
+	})
+}
+
+func testAccManagementGroupHierarchySettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_hierarchy_settings", "test")
+	r := ManagementGroupHierarchySettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccManagementGroupHierarchySettings_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_hierarchy_settings", "test")
+	r := ManagementGroupHierarchySettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("require_authorization_for_group_creation").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccManagementGroupHierarchySettings_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_hierarchy_settings", "test")
+	r := ManagementGroupHierarchySettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (ManagementGroupHierarchySettingsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseManagementGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ManagementGroups.GroupsClient.HierarchySettingsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Hierarchy Settings for %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (ManagementGroupHierarchySettingsResource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_management_group_hierarchy_settings" "test" {
+  management_group_id = "/providers/Microsoft.Management/managementGroups/${data.azurerm_client_config.current.tenant_id}"
+}
+`
+}
+
+func (r ManagementGroupHierarchySettingsResource) requiresImport(_ acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_hierarchy_settings" "import" {
+  management_group_id = azurerm_management_group_hierarchy_settings.test.management_group_id
+}
+`, r.basic())
+}
+
+func (ManagementGroupHierarchySettingsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_management_group" "test" {
+  display_name = "acctestmg-%d"
+}
+
+resource "azurerm_management_group_hierarchy_settings" "test" {
+  management_group_id                      = "/providers/Microsoft.Management/managementGroups/${data.azurerm_client_config.current.tenant_id}"
+  default_management_group_id              = azurerm_management_group.test.id
+  require_authorization_for_group_creation = true
+}
+`, data.RandomInteger)
+}
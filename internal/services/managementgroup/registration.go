@@ -31,7 +31,8 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_management_group": dataSourceManagementGroup(),
+		"azurerm_management_group":             dataSourceManagementGroup(),
+		"azurerm_management_group_descendants": dataSourceManagementGroupDescendants(),
 	}
 }
 
@@ -39,6 +40,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_management_group":                          resourceManagementGroup(),
+		"azurerm_management_group_hierarchy_settings":       resourceManagementGroupHierarchySettings(),
 		"azurerm_management_group_subscription_association": resourceManagementGroupSubscriptionAssociation(),
 	}
 }
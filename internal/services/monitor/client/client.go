@@ -25,6 +25,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-15-preview/scheduledqueryrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-04-03/azuremonitorworkspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-10-01/metricdefinitions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-10-01/metrics"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -50,6 +52,8 @@ type Client struct {
 	DiagnosticSettingsClient             *diagnosticSettingClient.DiagnosticSettingsClient
 	DiagnosticSettingsCategoryClient     *diagnosticCategoryClient.DiagnosticSettingsCategoriesClient
 	MetricAlertsClient                   *metricalerts.MetricAlertsClient
+	MetricDefinitionsClient              *metricdefinitions.MetricDefinitionsClient
+	MetricsClient                        *metrics.MetricsClient
 	PrivateLinkScopesClient              *privatelinkscopesapis.PrivateLinkScopesAPIsClient
 	PrivateLinkScopedResourcesClient     *privatelinkscopedresources.PrivateLinkScopedResourcesClient
 	ScheduledQueryRulesClient            *scheduledqueryrules2018.ScheduledQueryRulesClient
@@ -142,6 +146,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(MetricAlertsClient.Client, o.Authorizers.ResourceManager)
 
+	MetricDefinitionsClient, err := metricdefinitions.NewMetricDefinitionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Metric Definitions client: %+v", err)
+	}
+	o.Configure(MetricDefinitionsClient.Client, o.Authorizers.ResourceManager)
+
+	MetricsClient, err := metrics.NewMetricsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Metrics client: %+v", err)
+	}
+	o.Configure(MetricsClient.Client, o.Authorizers.ResourceManager)
+
 	PrivateLinkScopesClient, err := privatelinkscopesapis.NewPrivateLinkScopesAPIsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Private Link Scopes client: %+v", err)
@@ -187,6 +203,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		DiagnosticSettingsClient:             DiagnosticSettingsClient,
 		DiagnosticSettingsCategoryClient:     DiagnosticSettingsCategoryClient,
 		MetricAlertsClient:                   MetricAlertsClient,
+		MetricDefinitionsClient:              MetricDefinitionsClient,
+		MetricsClient:                        MetricsClient,
 		PrivateLinkScopesClient:              PrivateLinkScopesClient,
 		PrivateLinkScopedResourcesClient:     PrivateLinkScopedResourcesClient,
 		ScheduledQueryRulesClient:            ScheduledQueryRulesClient,
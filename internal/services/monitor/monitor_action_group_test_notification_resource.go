@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-01-01/actiongroupsapis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	actionGroupTestNotificationStateComplete = "Complete"
+	actionGroupTestNotificationStateFailed   = "Failed"
+	actionGroupTestNotificationStatePending  = "Pending"
+)
+
+type ActionGroupTestNotificationResource struct{}
+
+var _ sdk.Resource = ActionGroupTestNotificationResource{}
+
+type ActionGroupTestNotificationResourceModel struct {
+	ActionGroupId         string                                `tfschema:"action_group_id"`
+	AlertType             string                                `tfschema:"alert_type"`
+	FailOnReceiverFailure bool                                  `tfschema:"fail_on_receiver_failure"`
+	Triggers              map[string]string                     `tfschema:"triggers"`
+	NotificationId        string                                `tfschema:"notification_id"`
+	State                 string                                `tfschema:"state"`
+	CompletedTime         string                                `tfschema:"completed_time"`
+	Receiver              []ActionGroupTestNotificationReceiver `tfschema:"receiver"`
+}
+
+type ActionGroupTestNotificationReceiver struct {
+	Name     string `tfschema:"name"`
+	Type     string `tfschema:"type"`
+	Status   string `tfschema:"status"`
+	SubState string `tfschema:"sub_state"`
+	Detail   string `tfschema:"detail"`
+	SendTime string `tfschema:"send_time"`
+}
+
+func (r ActionGroupTestNotificationResource) ResourceType() string {
+	return "azurerm_monitor_action_group_test_notification"
+}
+
+func (r ActionGroupTestNotificationResource) ModelObject() interface{} {
+	return &ActionGroupTestNotificationResourceModel{}
+}
+
+func (r ActionGroupTestNotificationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return actiongroupsapis.ValidateNotificationStatusID
+}
+
+func (r ActionGroupTestNotificationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"action_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: actiongroupsapis.ValidateActionGroupID,
+		},
+
+		"alert_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"activitylog",
+				"actualcostbudget",
+				"forecastedbudget",
+				"logalertv1metricmeasurement",
+				"logalertv1numresult",
+				"logalertv2",
+				"metricsdynamicthreshold",
+				"metricstaticthreshold",
+				"resourcehealth",
+				"servicehealth",
+				"smartalert",
+				"webtestalert",
+			}, false),
+		},
+
+		"fail_on_receiver_failure": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ActionGroupTestNotificationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"notification_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"completed_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"receiver": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"sub_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"detail": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"send_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ActionGroupTestNotificationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.ActionGroupsClient
+
+			var model ActionGroupTestNotificationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			actionGroupId, err := actiongroupsapis.ParseActionGroupID(model.ActionGroupId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.ActionGroupsGet(ctx, *actionGroupId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *actionGroupId, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *actionGroupId)
+			}
+
+			// the test notification is sent to the receivers specified in the request, which are those of the Action Group
+			props := existing.Model.Properties
+			input := actiongroupsapis.NotificationRequestBody{
+				AlertType:                  model.AlertType,
+				ArmRoleReceivers:           props.ArmRoleReceivers,
+				AutomationRunbookReceivers: props.AutomationRunbookReceivers,
+				AzureAppPushReceivers:      props.AzureAppPushReceivers,
+				AzureFunctionReceivers:     props.AzureFunctionReceivers,
+				EmailReceivers:             props.EmailReceivers,
+				EventHubReceivers:          props.EventHubReceivers,
+				ItsmReceivers:              props.ItsmReceivers,
+				LogicAppReceivers:          props.LogicAppReceivers,
+				SmsReceivers:               props.SmsReceivers,
+				VoiceReceivers:             props.VoiceReceivers,
+				WebhookReceivers:           props.WebhookReceivers,
+			}
+
+			resp, err := client.ActionGroupsCreateNotificationsAtActionGroupResourceLevel(ctx, *actionGroupId, input)
+			if err != nil {
+				return fmt.Errorf("sending test notification for %s: %+v", *actionGroupId, err)
+			}
+
+			// the status of the notification is polled using the ID returned in the `Location` header, since it's
+			// required to retrieve the status of each receiver once the notification has completed
+			if resp.HttpResponse == nil {
+				return fmt.Errorf("sending test notification for %s: response was nil", *actionGroupId)
+			}
+			location := resp.HttpResponse.Header.Get("Location")
+			notificationId := ""
+			if index := strings.LastIndex(location, "/notificationStatus/"); index != -1 {
+				notificationId = strings.SplitN(location[index+len("/notificationStatus/"):], "?", 2)[0]
+			}
+			if notificationId == "" {
+				return fmt.Errorf("sending test notification for %s: unable to determine the notification ID from the `Location` header %q", *actionGroupId, location)
+			}
+
+			id := actiongroupsapis.NewNotificationStatusID(actionGroupId.SubscriptionId, actionGroupId.ResourceGroupName, actionGroupId.ActionGroupName, notificationId)
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:    []string{actionGroupTestNotificationStatePending},
+				Target:     []string{actionGroupTestNotificationStateComplete, actionGroupTestNotificationStateFailed},
+				Refresh:    actionGroupTestNotificationRefreshFunc(ctx, client, id),
+				MinTimeout: 15 * time.Second,
+				Timeout:    time.Until(deadline),
+			}
+			result, err := stateConf.WaitForStateContext(ctx)
+			if err != nil {
+				return fmt.Errorf("waiting for %s to complete: %+v", id, err)
+			}
+
+			details := result.(actiongroupsapis.TestNotificationDetailsResponse)
+			model.NotificationId = notificationId
+			model.State = details.State
+			model.CompletedTime = pointer.From(details.CompletedTime)
+			model.Receiver = flattenActionGroupTestNotificationReceivers(details.ActionDetails)
+
+			if model.FailOnReceiverFailure {
+				failed := make([]string, 0)
+				for _, v := range model.Receiver {
+					if actionGroupTestNotificationReceiverFailed(v.Status) {
+						failed = append(failed, fmt.Sprintf("%s (%s): %s %s", v.Name, v.Type, v.Status, v.Detail))
+					}
+				}
+				if strings.EqualFold(details.State, actionGroupTestNotificationStateFailed) || len(failed) > 0 {
+					return fmt.Errorf("test notification for %s completed with state %q, failed receivers:\n%s", *actionGroupId, details.State, strings.Join(failed, "\n"))
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ActionGroupTestNotificationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.ActionGroupsClient
+
+			id, err := actiongroupsapis.ParseNotificationStatusID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the status of a test notification is only retained for a limited time, so the result recorded when the
+			// notification was sent is kept, and the resource is only removed when the Action Group no longer exists
+			actionGroupId := actiongroupsapis.NewActionGroupID(id.SubscriptionId, id.ResourceGroupName, id.ActionGroupName)
+			resp, err := client.ActionGroupsGet(ctx, actionGroupId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", actionGroupId, err)
+			}
+
+			var state ActionGroupTestNotificationResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.ActionGroupId = actionGroupId.ID()
+			state.NotificationId = id.NotificationId
+
+			// the details are only refreshed when they're available, which is the case when the resource is imported
+			if state.State == "" {
+				state.FailOnReceiverFailure = true
+
+				notification, err := client.ActionGroupsGetTestNotificationsAtActionGroupResourceLevel(ctx, *id)
+				if err != nil {
+					if response.WasNotFound(notification.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+
+				if model := notification.Model; model != nil {
+					state.State = model.State
+					state.CompletedTime = pointer.From(model.CompletedTime)
+					state.Receiver = flattenActionGroupTestNotificationReceivers(model.ActionDetails)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ActionGroupTestNotificationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := actiongroupsapis.ParseNotificationStatusID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// a test notification can't be deleted, so it's only removed from the state
+			log.Printf("[DEBUG] removing %s from the state", *id)
+
+			return nil
+		},
+	}
+}
+
+func actionGroupTestNotificationRefreshFunc(ctx context.Context, client *actiongroupsapis.ActionGroupsAPIsClient, id actiongroupsapis.NotificationStatusId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.ActionGroupsGetTestNotificationsAtActionGroupResourceLevel(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		if resp.Model == nil {
+			return nil, "", fmt.Errorf("retrieving %s: model was nil", id)
+		}
+
+		switch {
+		case strings.EqualFold(resp.Model.State, actionGroupTestNotificationStateComplete), strings.EqualFold(resp.Model.State, "Completed"):
+			return *resp.Model, actionGroupTestNotificationStateComplete, nil
+		case strings.EqualFold(resp.Model.State, actionGroupTestNotificationStateFailed):
+			return *resp.Model, actionGroupTestNotificationStateFailed, nil
+		}
+
+		return *resp.Model, actionGroupTestNotificationStatePending, nil
+	}
+}
+
+func flattenActionGroupTestNotificationReceivers(input *[]actiongroupsapis.ActionDetail) []ActionGroupTestNotificationReceiver {
+	output := make([]ActionGroupTestNotificationReceiver, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ActionGroupTestNotificationReceiver{
+			Name:     pointer.From(v.Name),
+			Type:     pointer.From(v.MechanismType),
+			Status:   pointer.From(v.Status),
+			SubState: pointer.From(v.SubState),
+			Detail:   pointer.From(v.Detail),
+			SendTime: pointer.From(v.SendTime),
+		})
+	}
+
+	return output
+}
+
+func actionGroupTestNotificationReceiverFailed(status string) bool {
+	return strings.Contains(strings.ToLower(status), "fail")
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-01-01/actiongroupsapis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MonitorActionGroupTestNotificationResource struct{}
+
+func TestAccMonitorActionGroupTestNotification_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_action_group_test_notification", "test")
+	r := MonitorActionGroupTestNotificationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "v1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("notification_id").Exists(),
+				check.That(data.ResourceName).Key("state").Exists(),
+				check.That(data.ResourceName).Key("receiver.#").HasValue("1"),
+				check.That(data.ResourceName).Key("receiver.0.name").HasValue("sendtoadmin"),
+			),
+		},
+	})
+}
+
+func TestAccMonitorActionGroupTestNotification_triggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_action_group_test_notification", "test")
+	r := MonitorActionGroupTestNotificationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "v1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.basic(data, "v2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("receiver.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (MonitorActionGroupTestNotificationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := actiongroupsapis.ParseNotificationStatusID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Monitor.ActionGroupsClient.ActionGroupsGetTestNotificationsAtActionGroupResourceLevel(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (MonitorActionGroupTestNotificationResource) basic(data acceptance.TestData, release string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestag"
+
+  email_receiver {
+    name          = "sendtoadmin"
+    email_address = "admin@contoso.com"
+  }
+}
+
+resource "azurerm_monitor_action_group_test_notification" "test" {
+  action_group_id          = azurerm_monitor_action_group.test.id
+  alert_type               = "servicehealth"
+  fail_on_receiver_failure = false
+
+  triggers = {
+    release = "%s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, release)
+}
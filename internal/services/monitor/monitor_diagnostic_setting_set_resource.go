@@ -0,0 +1,876 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	authRuleParse "github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/authorizationrulesnamespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategories"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resources"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var diagnosticSettingSetResourceTypeRegex = regexp.MustCompile(`^[A-Za-z0-9.]+(/[A-Za-z0-9]+)+$`)
+
+const (
+	diagnosticSettingSetStatusInSync      = "InSync"
+	diagnosticSettingSetStatusDrifted     = "Drifted"
+	diagnosticSettingSetStatusMissing     = "Missing"
+	diagnosticSettingSetStatusUnsupported = "Unsupported"
+	diagnosticSettingSetStatusUnmanaged   = "Unmanaged"
+
+	diagnosticSettingSetAllMetricsCategory = "AllMetrics"
+
+	// diagnosticSettingSetParallelism is the number of Diagnostic Settings retrieved at once during a refresh
+	diagnosticSettingSetParallelism = 10
+)
+
+type DiagnosticSettingSetResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = DiagnosticSettingSetResource{}
+	_ sdk.ResourceWithCustomizeDiff = DiagnosticSettingSetResource{}
+)
+
+type DiagnosticSettingSetResourceModel struct {
+	Name                        string                            `tfschema:"name"`
+	ScopeId                     string                            `tfschema:"scope_id"`
+	ResourceTypes               []string                          `tfschema:"resource_types"`
+	ExcludedResourceIds         []string                          `tfschema:"excluded_resource_ids"`
+	EnabledLogCategoryGroups    []string                          `tfschema:"enabled_log_category_groups"`
+	MetricsEnabled              bool                              `tfschema:"metrics_enabled"`
+	EventHubAuthorizationRuleId string                            `tfschema:"eventhub_authorization_rule_id"`
+	EventHubName                string                            `tfschema:"eventhub_name"`
+	LogAnalyticsWorkspaceId     string                            `tfschema:"log_analytics_workspace_id"`
+	LogAnalyticsDestinationType string                            `tfschema:"log_analytics_destination_type"`
+	StorageAccountId            string                            `tfschema:"storage_account_id"`
+	PartnerSolutionId           string                            `tfschema:"partner_solution_id"`
+	Resource                    []DiagnosticSettingSetTargetModel `tfschema:"resource"`
+}
+
+type DiagnosticSettingSetTargetModel struct {
+	Id     string `tfschema:"id"`
+	Status string `tfschema:"status"`
+}
+
+func (r DiagnosticSettingSetResource) ResourceType() string {
+	return "azurerm_monitor_diagnostic_setting_set"
+}
+
+func (r DiagnosticSettingSetResource) ModelObject() interface{} {
+	return &DiagnosticSettingSetResourceModel{}
+}
+
+func (r DiagnosticSettingSetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.DiagnosticSettingSetID
+}
+
+func (r DiagnosticSettingSetResource) Arguments() map[string]*pluginsdk.Schema {
+	destinations := []string{"eventhub_authorization_rule_id", "log_analytics_workspace_id", "storage_account_id", "partner_solution_id"}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.MonitorDiagnosticSettingName,
+		},
+
+		"scope_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				commonids.ValidateSubscriptionID,
+				commonids.ValidateResourceGroupID,
+			),
+		},
+
+		"resource_types": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringMatch(diagnosticSettingSetResourceTypeRegex, "expected a resource type in the format `{Namespace}/{type}`, such as `Microsoft.KeyVault/vaults`"),
+			},
+		},
+
+		"excluded_resource_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+
+		"enabled_log_category_groups": {
+			Type:         pluginsdk.TypeSet,
+			Optional:     true,
+			AtLeastOneOf: []string{"enabled_log_category_groups", "metrics_enabled"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"metrics_enabled": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			Default:      false,
+			AtLeastOneOf: []string{"enabled_log_category_groups", "metrics_enabled"},
+		},
+
+		"eventhub_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: eventhubValidate.ValidateEventHubName(),
+			RequiredWith: []string{"eventhub_authorization_rule_id"},
+		},
+
+		"eventhub_authorization_rule_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: authRuleParse.ValidateAuthorizationRuleID,
+			AtLeastOneOf: destinations,
+		},
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+			AtLeastOneOf: destinations,
+		},
+
+		"log_analytics_destination_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Dedicated",
+				"AzureDiagnostics",
+			}, false),
+			RequiredWith: []string{"log_analytics_workspace_id"},
+		},
+
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+			AtLeastOneOf: destinations,
+		},
+
+		"partner_solution_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: azure.ValidateResourceID,
+			AtLeastOneOf: destinations,
+		},
+	}
+}
+
+func (r DiagnosticSettingSetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r DiagnosticSettingSetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model DiagnosticSettingSetResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewDiagnosticSettingSetID(model.ScopeId, model.Name)
+
+			// the ID is set up-front so that any Diagnostic Settings created before a failure are tracked (and
+			// subsequently removed) - existing Diagnostic Settings with the same name are left alone and reported
+			// as `Unmanaged`, since they weren't created by this resource
+			metadata.SetID(id)
+
+			resources, err := r.apply(ctx, metadata, id, model, nil)
+			if err := metadata.ResourceData.Set("resource", flattenDiagnosticSettingSetTargets(resources)); err != nil {
+				return fmt.Errorf("setting `resource`: %+v", err)
+			}
+
+			return err
+		},
+	}
+}
+
+func (r DiagnosticSettingSetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+			id, err := parse.DiagnosticSettingSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state DiagnosticSettingSetResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.Name = id.Name
+			state.ScopeId = id.Scope
+
+			targets, err := r.listTargets(ctx, metadata, *id, state)
+			if err != nil {
+				return err
+			}
+
+			categories := newDiagnosticSettingSetCategoryCache(metadata.Client.Monitor.DiagnosticSettingsCategoryClient)
+
+			expected := make(map[string]*diagnosticsettings.DiagnosticSettings)
+			supported := make([]string, 0)
+			for _, targetId := range targets {
+				properties, err := categories.expand(ctx, targetId, state)
+				if err != nil {
+					return err
+				}
+				expected[strings.ToLower(targetId)] = properties
+				if properties != nil {
+					supported = append(supported, targetId)
+				}
+			}
+
+			existing, err := retrieveDiagnosticSettingSetSettings(ctx, client, id.Name, supported)
+			if err != nil {
+				return err
+			}
+
+			// the status of each matching resource is recorded so that drift (including newly created resources,
+			// which won't have the Diagnostic Setting) results in a diff which is resolved on the next apply
+			previous := diagnosticSettingSetStatuses(state.Resource)
+			state.Resource = make([]DiagnosticSettingSetTargetModel, 0)
+			for _, targetId := range targets {
+				key := strings.ToLower(targetId)
+				properties := expected[key]
+				if properties == nil {
+					state.Resource = append(state.Resource, DiagnosticSettingSetTargetModel{
+						Id:     targetId,
+						Status: diagnosticSettingSetStatusUnsupported,
+					})
+					continue
+				}
+
+				status := diagnosticSettingSetStatusMissing
+				if setting, ok := existing[key]; ok {
+					switch {
+					case !diagnosticSettingSetOwned(previous[key]):
+						status = diagnosticSettingSetStatusUnmanaged
+					case !diagnosticSettingSetPropertiesMatch(*properties, setting):
+						status = diagnosticSettingSetStatusDrifted
+					default:
+						status = diagnosticSettingSetStatusInSync
+					}
+				}
+
+				state.Resource = append(state.Resource, DiagnosticSettingSetTargetModel{
+					Id:     targetId,
+					Status: status,
+				})
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DiagnosticSettingSetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+			id, err := parse.DiagnosticSettingSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DiagnosticSettingSetResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			targets, err := r.listTargets(ctx, metadata, *id, model)
+			if err != nil {
+				return err
+			}
+
+			// resources which no longer match the filters (e.g. they've been excluded or their resource type removed)
+			// have the Diagnostic Setting removed
+			current := make(map[string]struct{})
+			for _, v := range targets {
+				current[strings.ToLower(v)] = struct{}{}
+			}
+			old, _ := metadata.ResourceData.GetChange("resource")
+			previous := make([]DiagnosticSettingSetTargetModel, 0)
+			for _, raw := range old.([]interface{}) {
+				v, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				target := DiagnosticSettingSetTargetModel{
+					Id:     v["id"].(string),
+					Status: v["status"].(string),
+				}
+				if !diagnosticSettingSetOwned(target.Status) {
+					previous = append(previous, target)
+					continue
+				}
+				if _, ok := current[strings.ToLower(target.Id)]; ok {
+					previous = append(previous, target)
+					continue
+				}
+
+				settingId := diagnosticsettings.NewScopedDiagnosticSettingID(target.Id, id.Name)
+				if resp, err := client.Delete(ctx, settingId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting Monitor Diagnostics Setting %q for Resource %q: %+v", settingId.DiagnosticSettingName, settingId.ResourceUri, err)
+				}
+			}
+
+			resources, err := r.apply(ctx, metadata, *id, model, diagnosticSettingSetStatuses(previous))
+			if err != nil {
+				// the resources which weren't reached keep their previous status, so that the Diagnostic Settings
+				// created by this resource continue to be tracked
+				resources = mergeDiagnosticSettingSetTargets(resources, previous)
+			}
+			if err := metadata.ResourceData.Set("resource", flattenDiagnosticSettingSetTargets(resources)); err != nil {
+				return fmt.Errorf("setting `resource`: %+v", err)
+			}
+
+			return err
+		},
+	}
+}
+
+func (r DiagnosticSettingSetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+			id, err := parse.DiagnosticSettingSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state DiagnosticSettingSetResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// only the Diagnostic Settings created by this resource are removed
+			for _, v := range state.Resource {
+				if !diagnosticSettingSetOwned(v.Status) {
+					continue
+				}
+
+				settingId := diagnosticsettings.NewScopedDiagnosticSettingID(v.Id, id.Name)
+				if resp, err := client.Delete(ctx, settingId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting Monitor Diagnostics Setting %q for Resource %q: %+v", settingId.DiagnosticSettingName, settingId.ResourceUri, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DiagnosticSettingSetResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceDiff.Id() == "" {
+				return nil
+			}
+
+			// drift is detected during the refresh, so an update is planned to re-apply the Diagnostic Settings
+			old, _ := metadata.ResourceDiff.GetChange("resource")
+			for _, raw := range old.([]interface{}) {
+				v, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				switch v["status"].(string) {
+				case diagnosticSettingSetStatusDrifted, diagnosticSettingSetStatusMissing:
+					return metadata.ResourceDiff.SetNewComputed("resource")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// apply creates or updates the Diagnostic Setting on each resource matching the filters, returning the status of each
+// resource. Resources which didn't previously have a Diagnostic Setting created by this resource are checked for an
+// existing one with the same name first, which is left alone rather than being taken over (and later deleted).
+func (r DiagnosticSettingSetResource) apply(ctx context.Context, metadata sdk.ResourceMetaData, id parse.DiagnosticSettingSetId, model DiagnosticSettingSetResourceModel, previous map[string]string) ([]DiagnosticSettingSetTargetModel, error) {
+	client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+	targets, err := r.listTargets(ctx, metadata, id, model)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := newDiagnosticSettingSetCategoryCache(metadata.Client.Monitor.DiagnosticSettingsCategoryClient)
+
+	expected := make(map[string]*diagnosticsettings.DiagnosticSettings)
+	unowned := make([]string, 0)
+	for _, targetId := range targets {
+		properties, err := categories.expand(ctx, targetId, model)
+		if err != nil {
+			return nil, err
+		}
+		key := strings.ToLower(targetId)
+		expected[key] = properties
+		if properties != nil && !diagnosticSettingSetOwned(previous[key]) {
+			unowned = append(unowned, targetId)
+		}
+	}
+
+	existing, err := retrieveDiagnosticSettingSetSettings(ctx, client, id.Name, unowned)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]DiagnosticSettingSetTargetModel, 0)
+	for _, targetId := range targets {
+		key := strings.ToLower(targetId)
+		properties := expected[key]
+		if properties == nil {
+			log.Printf("[DEBUG] Resource %q doesn't support any of the configured Log Category Groups or Metrics - skipping", targetId)
+			output = append(output, DiagnosticSettingSetTargetModel{
+				Id:     targetId,
+				Status: diagnosticSettingSetStatusUnsupported,
+			})
+			continue
+		}
+		if _, ok := existing[key]; ok {
+			log.Printf("[DEBUG] Resource %q already has a Diagnostic Setting named %q which wasn't created by this resource - skipping", targetId, id.Name)
+			output = append(output, DiagnosticSettingSetTargetModel{
+				Id:     targetId,
+				Status: diagnosticSettingSetStatusUnmanaged,
+			})
+			continue
+		}
+
+		settingId := diagnosticsettings.NewScopedDiagnosticSettingID(targetId, id.Name)
+		payload := diagnosticsettings.DiagnosticSettingsResource{
+			Properties: properties,
+		}
+		if _, err := client.CreateOrUpdate(ctx, settingId, payload); err != nil {
+			return output, fmt.Errorf("creating/updating Monitor Diagnostics Setting %q for Resource %q: %+v", settingId.DiagnosticSettingName, settingId.ResourceUri, err)
+		}
+
+		// the Diagnostic Setting is tracked from this point on, so that it's removed if waiting for it fails
+		output = append(output, DiagnosticSettingSetTargetModel{
+			Id:     targetId,
+			Status: diagnosticSettingSetStatusInSync,
+		})
+
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return output, fmt.Errorf("internal error: could not retrieve context deadline for %s", settingId.ID())
+		}
+
+		// https://github.com/Azure/azure-rest-api-specs/issues/30249
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:                   []string{"NotFound"},
+			Target:                    []string{"Exists"},
+			Refresh:                   monitorDiagnosticSettingRefreshFunc(ctx, client, settingId),
+			MinTimeout:                5 * time.Second,
+			ContinuousTargetOccurence: 3,
+			Timeout:                   time.Until(deadline),
+		}
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+			return output, fmt.Errorf("waiting for Monitor Diagnostic Setting %q for Resource %q to become ready: %s", settingId.DiagnosticSettingName, settingId.ResourceUri, err)
+		}
+	}
+
+	return output, nil
+}
+
+// retrieveDiagnosticSettingSetSettings retrieves the Diagnostic Setting with the given name from each of the resources,
+// a limited number at a time so that scopes containing many resources can be refreshed within the Read timeout. The
+// result is keyed by the lower-cased resource ID, and only contains the resources which have the Diagnostic Setting.
+func retrieveDiagnosticSettingSetSettings(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, name string, targetIds []string) (map[string]*diagnosticsettings.DiagnosticSettings, error) {
+	type result struct {
+		properties *diagnosticsettings.DiagnosticSettings
+		exists     bool
+		err        error
+	}
+
+	results := make([]result, len(targetIds))
+	limit := make(chan struct{}, diagnosticSettingSetParallelism)
+	wg := &sync.WaitGroup{}
+	wg.Add(len(targetIds))
+
+	for i, targetId := range targetIds {
+		go func(i int, targetId string) {
+			defer wg.Done()
+			limit <- struct{}{}
+			defer func() { <-limit }()
+
+			settingId := diagnosticsettings.NewScopedDiagnosticSettingID(targetId, name)
+			resp, err := client.Get(ctx, settingId)
+			if err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					results[i].err = fmt.Errorf("retrieving Monitor Diagnostics Setting %q for Resource %q: %+v", settingId.DiagnosticSettingName, settingId.ResourceUri, err)
+				}
+				return
+			}
+
+			results[i].exists = true
+			if resp.Model != nil {
+				results[i].properties = resp.Model.Properties
+			}
+		}(i, targetId)
+	}
+	wg.Wait()
+
+	output := make(map[string]*diagnosticsettings.DiagnosticSettings)
+	for i, targetId := range targetIds {
+		if err := results[i].err; err != nil {
+			return nil, err
+		}
+		if results[i].exists {
+			output[strings.ToLower(targetId)] = results[i].properties
+		}
+	}
+
+	return output, nil
+}
+
+// diagnosticSettingSetOwned returns whether the Diagnostic Setting on a resource was created by this resource, based on
+// the status recorded for it in the state
+func diagnosticSettingSetOwned(status string) bool {
+	switch status {
+	case diagnosticSettingSetStatusInSync, diagnosticSettingSetStatusDrifted, diagnosticSettingSetStatusMissing:
+		return true
+	}
+	return false
+}
+
+func diagnosticSettingSetStatuses(input []DiagnosticSettingSetTargetModel) map[string]string {
+	output := make(map[string]string)
+	for _, v := range input {
+		output[strings.ToLower(v.Id)] = v.Status
+	}
+	return output
+}
+
+func mergeDiagnosticSettingSetTargets(input []DiagnosticSettingSetTargetModel, previous []DiagnosticSettingSetTargetModel) []DiagnosticSettingSetTargetModel {
+	output := append(make([]DiagnosticSettingSetTargetModel, 0), input...)
+	existing := diagnosticSettingSetStatuses(input)
+	for _, v := range previous {
+		if _, ok := existing[strings.ToLower(v.Id)]; !ok {
+			output = append(output, v)
+		}
+	}
+	return output
+}
+
+func flattenDiagnosticSettingSetTargets(input []DiagnosticSettingSetTargetModel) []interface{} {
+	output := make([]interface{}, 0)
+	for _, v := range input {
+		output = append(output, map[string]interface{}{
+			"id":     v.Id,
+			"status": v.Status,
+		})
+	}
+	return output
+}
+
+// listTargets returns the IDs of the resources within the scope matching the resource types, sorted so that the
+// `resource` attribute is stable between refreshes
+func (r DiagnosticSettingSetResource) listTargets(ctx context.Context, metadata sdk.ResourceMetaData, id parse.DiagnosticSettingSetId, model DiagnosticSettingSetResourceModel) ([]string, error) {
+	excluded := make(map[string]struct{})
+	for _, v := range model.ExcludedResourceIds {
+		excluded[strings.ToLower(v)] = struct{}{}
+	}
+
+	resourceGroupId, resourceGroupErr := commonids.ParseResourceGroupIDInsensitively(id.Scope)
+
+	seen := make(map[string]struct{})
+	output := make([]string, 0)
+	for _, resourceType := range model.ResourceTypes {
+		filter := fmt.Sprintf("resourceType eq '%s'", resourceType)
+
+		ids := make([]*string, 0)
+		if resourceGroupErr == nil {
+			resp, err := metadata.Client.Resource.ResourceGroupsClient.ResourcesListByResourceGroupComplete(ctx, *resourceGroupId, resourcegroups.ResourcesListByResourceGroupOperationOptions{
+				Filter: pointer.To(filter),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("listing resources of type %q within %s: %+v", resourceType, *resourceGroupId, err)
+			}
+			for _, v := range resp.Items {
+				ids = append(ids, v.Id)
+			}
+		} else {
+			subscriptionId, err := commonids.ParseSubscriptionIDInsensitively(id.Scope)
+			if err != nil {
+				return nil, err
+			}
+			resp, err := metadata.Client.Resource.ResourcesClient.ListComplete(ctx, *subscriptionId, resources.ListOperationOptions{
+				Filter: pointer.To(filter),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("listing resources of type %q within %s: %+v", resourceType, *subscriptionId, err)
+			}
+			for _, v := range resp.Items {
+				ids = append(ids, v.Id)
+			}
+		}
+
+		for _, v := range ids {
+			if v == nil || *v == "" {
+				continue
+			}
+			key := strings.ToLower(*v)
+			if _, ok := excluded[key]; ok {
+				continue
+			}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			output = append(output, *v)
+		}
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return strings.ToLower(output[i]) < strings.ToLower(output[j])
+	})
+
+	return output, nil
+}
+
+// diagnosticSettingSetCategoryCache retrieves the Diagnostic Categories supported by each resource type once, since
+// these are the same for every resource of that type
+type diagnosticSettingSetCategoryCache struct {
+	client *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient
+	cache  map[string]diagnosticSettingSetCategories
+}
+
+type diagnosticSettingSetCategories struct {
+	categoryGroups []string
+	metrics        bool
+}
+
+func newDiagnosticSettingSetCategoryCache(client *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient) *diagnosticSettingSetCategoryCache {
+	return &diagnosticSettingSetCategoryCache{
+		client: client,
+		cache:  make(map[string]diagnosticSettingSetCategories),
+	}
+}
+
+func (c *diagnosticSettingSetCategoryCache) get(ctx context.Context, targetId string) (*diagnosticSettingSetCategories, error) {
+	key := strings.ToLower(diagnosticSettingSetResourceTypeOf(targetId))
+	if v, ok := c.cache[key]; ok {
+		return &v, nil
+	}
+
+	resp, err := c.client.DiagnosticSettingsCategoryList(ctx, commonids.NewScopeID(targetId))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Diagnostics Categories for Resource %q: %+v", targetId, err)
+	}
+
+	categories := diagnosticSettingSetCategories{
+		categoryGroups: make([]string, 0),
+	}
+	if model := resp.Model; model != nil && model.Value != nil {
+		for _, v := range *model.Value {
+			if v.Properties == nil || v.Properties.CategoryType == nil {
+				continue
+			}
+			switch *v.Properties.CategoryType {
+			case diagnosticsettingscategories.CategoryTypeLogs:
+				for _, group := range pointer.From(v.Properties.CategoryGroups) {
+					if !containsFold(categories.categoryGroups, group) {
+						categories.categoryGroups = append(categories.categoryGroups, group)
+					}
+				}
+			case diagnosticsettingscategories.CategoryTypeMetrics:
+				if strings.EqualFold(pointer.From(v.Name), diagnosticSettingSetAllMetricsCategory) {
+					categories.metrics = true
+				}
+			}
+		}
+	}
+
+	c.cache[key] = categories
+	return &categories, nil
+}
+
+// expand returns the Diagnostic Setting for the resource, enabling only the Log Category Groups and Metrics that the
+// resource supports - or nil when the resource supports none of them
+func (c *diagnosticSettingSetCategoryCache) expand(ctx context.Context, targetId string, model DiagnosticSettingSetResourceModel) (*diagnosticsettings.DiagnosticSettings, error) {
+	categories, err := c.get(ctx, targetId)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]diagnosticsettings.LogSettings, 0)
+	for _, group := range model.EnabledLogCategoryGroups {
+		if containsFold(categories.categoryGroups, group) {
+			logs = append(logs, diagnosticsettings.LogSettings{
+				CategoryGroup: pointer.To(group),
+				Enabled:       true,
+			})
+		}
+	}
+
+	metrics := make([]diagnosticsettings.MetricSettings, 0)
+	if model.MetricsEnabled && categories.metrics {
+		metrics = append(metrics, diagnosticsettings.MetricSettings{
+			Category: pointer.To(diagnosticSettingSetAllMetricsCategory),
+			Enabled:  true,
+		})
+	}
+
+	// if no logs/metrics are enabled the API "creates" but 404's on Read
+	if len(logs) == 0 && len(metrics) == 0 {
+		return nil, nil
+	}
+
+	output := diagnosticsettings.DiagnosticSettings{
+		Logs:    &logs,
+		Metrics: &metrics,
+	}
+	if model.EventHubAuthorizationRuleId != "" {
+		output.EventHubAuthorizationRuleId = pointer.To(model.EventHubAuthorizationRuleId)
+		output.EventHubName = pointer.To(model.EventHubName)
+	}
+	if model.LogAnalyticsWorkspaceId != "" {
+		output.WorkspaceId = pointer.To(model.LogAnalyticsWorkspaceId)
+	}
+	if model.LogAnalyticsDestinationType != "" {
+		output.LogAnalyticsDestinationType = pointer.To(model.LogAnalyticsDestinationType)
+	}
+	if model.StorageAccountId != "" {
+		output.StorageAccountId = pointer.To(model.StorageAccountId)
+	}
+	if model.PartnerSolutionId != "" {
+		output.MarketplacePartnerId = pointer.To(model.PartnerSolutionId)
+	}
+
+	return &output, nil
+}
+
+// diagnosticSettingSetPropertiesMatch compares the destinations, Log Category Groups and Metrics of an existing
+// Diagnostic Setting with those which would be applied
+func diagnosticSettingSetPropertiesMatch(expected diagnosticsettings.DiagnosticSettings, actual *diagnosticsettings.DiagnosticSettings) bool {
+	if actual == nil {
+		return false
+	}
+
+	stringsMatch := func(expected, actual *string) bool {
+		return strings.EqualFold(pointer.From(expected), pointer.From(actual))
+	}
+	if !stringsMatch(expected.EventHubAuthorizationRuleId, actual.EventHubAuthorizationRuleId) ||
+		!stringsMatch(expected.EventHubName, actual.EventHubName) ||
+		!stringsMatch(expected.WorkspaceId, actual.WorkspaceId) ||
+		!stringsMatch(expected.StorageAccountId, actual.StorageAccountId) ||
+		!stringsMatch(expected.MarketplacePartnerId, actual.MarketplacePartnerId) {
+		return false
+	}
+
+	// the destination type is computed by the API when it's not specified
+	if expected.LogAnalyticsDestinationType != nil && !stringsMatch(expected.LogAnalyticsDestinationType, actual.LogAnalyticsDestinationType) {
+		return false
+	}
+
+	expectedLogs := make([]string, 0)
+	for _, v := range pointer.From(expected.Logs) {
+		expectedLogs = append(expectedLogs, strings.ToLower(pointer.From(v.CategoryGroup)))
+	}
+	actualLogs := make([]string, 0)
+	for _, v := range pointer.From(actual.Logs) {
+		if !v.Enabled {
+			continue
+		}
+		// individually enabled categories mean that the setting has been modified outside of Terraform
+		if v.Category != nil && *v.Category != "" {
+			return false
+		}
+		actualLogs = append(actualLogs, strings.ToLower(pointer.From(v.CategoryGroup)))
+	}
+	sort.Strings(expectedLogs)
+	sort.Strings(actualLogs)
+	if strings.Join(expectedLogs, ",") != strings.Join(actualLogs, ",") {
+		return false
+	}
+
+	expectedMetrics := len(pointer.From(expected.Metrics)) > 0
+	actualMetrics := false
+	for _, v := range pointer.From(actual.Metrics) {
+		if v.Enabled {
+			actualMetrics = true
+		}
+	}
+
+	return expectedMetrics == actualMetrics
+}
+
+// diagnosticSettingSetResourceTypeOf returns the fully qualified (and potentially nested) resource type of the
+// resource, e.g. `Microsoft.KeyVault/vaults` or `Microsoft.Sql/servers/databases`
+func diagnosticSettingSetResourceTypeOf(input string) string {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	output := make([]string, 0)
+	for i, v := range segments {
+		if strings.EqualFold(v, "providers") && i+1 < len(segments) {
+			output = []string{segments[i+1]}
+			// types and names alternate after the provider namespace
+			for j := i + 2; j < len(segments); j += 2 {
+				output = append(output, segments[j])
+			}
+		}
+	}
+	return strings.Join(output, "/")
+}
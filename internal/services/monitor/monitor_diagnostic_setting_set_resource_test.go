@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MonitorDiagnosticSettingSetResource struct{}
+
+func TestAccMonitorDiagnosticSettingSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting_set", "test")
+	r := MonitorDiagnosticSettingSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource.#").HasValue("2"),
+				check.That(data.ResourceName).Key("resource.0.status").HasValue("InSync"),
+				check.That(data.ResourceName).Key("resource.1.status").HasValue("InSync"),
+			),
+		},
+	})
+}
+
+func TestAccMonitorDiagnosticSettingSet_newResource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting_set", "test")
+	r := MonitorDiagnosticSettingSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource.#").HasValue("1"),
+			),
+		},
+		{
+			// the new Key Vault is created after the set has been refreshed, so is only picked up on the next apply
+			Config:             r.basic(data, 2),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.basic(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource.#").HasValue("2"),
+				check.That(data.ResourceName).Key("resource.0.status").HasValue("InSync"),
+				check.That(data.ResourceName).Key("resource.1.status").HasValue("InSync"),
+			),
+		},
+	})
+}
+
+func TestAccMonitorDiagnosticSettingSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting_set", "test")
+	r := MonitorDiagnosticSettingSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.basic(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccMonitorDiagnosticSettingSet_existingSetting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting_set", "test")
+	r := MonitorDiagnosticSettingSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// the existing Diagnostic Setting with the same name isn't taken over, and so is left in place on destroy
+			Config: r.existingSetting(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource.#").HasValue("2"),
+				check.That(data.ResourceName).Key("resource.0.status").HasValue("Unmanaged"),
+				check.That(data.ResourceName).Key("resource.1.status").HasValue("InSync"),
+				check.That("azurerm_monitor_diagnostic_setting.existing").ExistsInAzure(MonitorDiagnosticSettingResource{}),
+			),
+		},
+	})
+}
+
+func (t MonitorDiagnosticSettingSetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DiagnosticSettingSetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := strconv.Atoi(state.Attributes["resource.#"])
+	if err != nil {
+		return nil, fmt.Errorf("parsing `resource.#`: %+v", err)
+	}
+
+	for i := 0; i < count; i++ {
+		targetId := state.Attributes[fmt.Sprintf("resource.%d.id", i)]
+		if status := state.Attributes[fmt.Sprintf("resource.%d.status", i)]; status == "Unsupported" || status == "Unmanaged" {
+			continue
+		}
+
+		settingId := diagnosticsettings.NewScopedDiagnosticSettingID(targetId, id.Name)
+		resp, err := clients.Monitor.DiagnosticSettingsClient.Get(ctx, settingId)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", settingId, err)
+		}
+	}
+
+	return pointer.To(true), nil
+}
+
+func (MonitorDiagnosticSettingSetResource) template(data acceptance.TestData, vaults int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-LAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_key_vault" "test" {
+  count               = %[4]d
+  name                = "acctest${count.index}%[3]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomIntOfLength(15), vaults)
+}
+
+func (r MonitorDiagnosticSettingSetResource) basic(data acceptance.TestData, vaults int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_diagnostic_setting_set" "test" {
+  name                        = "acctest-DS-%d"
+  scope_id                    = azurerm_resource_group.test.id
+  resource_types              = ["Microsoft.KeyVault/vaults"]
+  log_analytics_workspace_id  = azurerm_log_analytics_workspace.test.id
+  enabled_log_category_groups = ["allLogs"]
+
+  depends_on = [azurerm_key_vault.test]
+}
+`, r.template(data, vaults), data.RandomInteger)
+}
+
+func (r MonitorDiagnosticSettingSetResource) existingSetting(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_diagnostic_setting" "existing" {
+  name                       = "acctest-DS-%[2]d"
+  target_resource_id         = azurerm_key_vault.test[0].id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+
+  enabled_log {
+    category_group = "audit"
+  }
+}
+
+resource "azurerm_monitor_diagnostic_setting_set" "test" {
+  name                        = "acctest-DS-%[2]d"
+  scope_id                    = azurerm_resource_group.test.id
+  resource_types              = ["Microsoft.KeyVault/vaults"]
+  log_analytics_workspace_id  = azurerm_log_analytics_workspace.test.id
+  enabled_log_category_groups = ["allLogs"]
+
+  depends_on = [azurerm_key_vault.test, azurerm_monitor_diagnostic_setting.existing]
+}
+`, r.template(data, 2), data.RandomInteger)
+}
+
+func (r MonitorDiagnosticSettingSetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_monitor_diagnostic_setting_set" "test" {
+  name                           = "acctest-DS-%d"
+  scope_id                       = azurerm_resource_group.test.id
+  resource_types                 = ["Microsoft.KeyVault/vaults", "Microsoft.Storage/storageAccounts"]
+  excluded_resource_ids          = [azurerm_key_vault.test[1].id]
+  log_analytics_workspace_id     = azurerm_log_analytics_workspace.test.id
+  log_analytics_destination_type = "Dedicated"
+  storage_account_id             = azurerm_storage_account.test.id
+  enabled_log_category_groups    = ["allLogs", "audit"]
+  metrics_enabled                = true
+
+  depends_on = [azurerm_key_vault.test]
+}
+`, r.template(data, 2), data.RandomString, data.RandomInteger)
+}
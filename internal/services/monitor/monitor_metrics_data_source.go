@@ -0,0 +1,524 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-10-01/metricdefinitions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-10-01/metrics"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/rickb777/date/period"
+)
+
+// metricsIntervalFull returns a single data point for the whole timespan
+const metricsIntervalFull = "FULL"
+
+type MetricsDataSource struct{}
+
+var _ sdk.DataSource = MetricsDataSource{}
+
+type MetricsDataSourceModel struct {
+	ResourceId      string                        `tfschema:"resource_id"`
+	MetricNames     []string                      `tfschema:"metric_names"`
+	MetricNamespace string                        `tfschema:"metric_namespace"`
+	Aggregations    []string                      `tfschema:"aggregations"`
+	Interval        string                        `tfschema:"interval"`
+	Timespan        string                        `tfschema:"timespan"`
+	DimensionFilter []MetricsDimensionFilterModel `tfschema:"dimension_filter"`
+	Top             int64                         `tfschema:"top"`
+	Metric          []MetricsMetricModel          `tfschema:"metric"`
+}
+
+type MetricsDimensionFilterModel struct {
+	Name     string   `tfschema:"name"`
+	Operator string   `tfschema:"operator"`
+	Values   []string `tfschema:"values"`
+}
+
+type MetricsMetricModel struct {
+	Name        string                   `tfschema:"name"`
+	Unit        string                   `tfschema:"unit"`
+	Description string                   `tfschema:"description"`
+	Timeseries  []MetricsTimeseriesModel `tfschema:"timeseries"`
+}
+
+type MetricsTimeseriesModel struct {
+	Dimensions map[string]string       `tfschema:"dimensions"`
+	Data       []MetricsDataPointModel `tfschema:"data"`
+}
+
+type MetricsDataPointModel struct {
+	Timestamp string  `tfschema:"timestamp"`
+	Average   float64 `tfschema:"average"`
+	Count     float64 `tfschema:"count"`
+	Maximum   float64 `tfschema:"maximum"`
+	Minimum   float64 `tfschema:"minimum"`
+	Total     float64 `tfschema:"total"`
+}
+
+func (MetricsDataSource) ResourceType() string {
+	return "azurerm_monitor_metrics"
+}
+
+func (MetricsDataSource) ModelObject() interface{} {
+	return &MetricsDataSourceModel{}
+}
+
+func (MetricsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"metric_names": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 20,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				// the metric names are sent to the API as a comma separated list
+				ValidateFunc: validation.All(
+					validation.StringIsNotEmpty,
+					validation.StringDoesNotContainAny(","),
+				),
+			},
+		},
+
+		"metric_namespace": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"aggregations": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(metricdefinitions.AggregationTypeAverage),
+					string(metricdefinitions.AggregationTypeCount),
+					string(metricdefinitions.AggregationTypeMaximum),
+					string(metricdefinitions.AggregationTypeMinimum),
+					string(metricdefinitions.AggregationTypeTotal),
+				}, false),
+			},
+		},
+
+		"interval": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "PT1M",
+			ValidateFunc: validation.StringInSlice([]string{
+				"PT1M",
+				"PT5M",
+				"PT15M",
+				"PT30M",
+				"PT1H",
+				"PT6H",
+				"PT12H",
+				"P1D",
+				metricsIntervalFull,
+			}, false),
+		},
+
+		"timespan": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "PT1H",
+			ValidateFunc: validate.MetricsTimespan,
+		},
+
+		"dimension_filter": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"operator": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  "Include",
+						ValidateFunc: validation.StringInSlice([]string{
+							"Include",
+							"Exclude",
+							"StartsWith",
+						}, false),
+					},
+
+					"values": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"top": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(1, 1000),
+		},
+	}
+}
+
+func (MetricsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"metric": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"unit": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"timeseries": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"dimensions": {
+									Type:     pluginsdk.TypeMap,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+
+								"data": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"timestamp": {
+												Type:     pluginsdk.TypeString,
+												Computed: true,
+											},
+
+											"average": {
+												Type:     pluginsdk.TypeFloat,
+												Computed: true,
+											},
+
+											"count": {
+												Type:     pluginsdk.TypeFloat,
+												Computed: true,
+											},
+
+											"maximum": {
+												Type:     pluginsdk.TypeFloat,
+												Computed: true,
+											},
+
+											"minimum": {
+												Type:     pluginsdk.TypeFloat,
+												Computed: true,
+											},
+
+											"total": {
+												Type:     pluginsdk.TypeFloat,
+												Computed: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (MetricsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			definitionsClient := metadata.Client.Monitor.MetricDefinitionsClient
+			metricsClient := metadata.Client.Monitor.MetricsClient
+
+			var state MetricsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := commonids.NewScopeID(state.ResourceId)
+
+			// the metric definitions are retrieved first so that a typo in a metric or dimension name results in an
+			// error listing the available values, rather than an empty result
+			definitionsOptions := metricdefinitions.DefaultListOperationOptions()
+			if state.MetricNamespace != "" {
+				definitionsOptions.Metricnamespace = pointer.To(state.MetricNamespace)
+			}
+			definitionsResp, err := definitionsClient.List(ctx, id, definitionsOptions)
+			if err != nil {
+				return fmt.Errorf("retrieving Metric Definitions for %s: %+v", id, err)
+			}
+			definitions := make([]metricdefinitions.MetricDefinition, 0)
+			if model := definitionsResp.Model; model != nil {
+				definitions = model.Value
+			}
+
+			aggregations, err := validateMetricsQuery(state, definitions)
+			if err != nil {
+				return fmt.Errorf("validating the query for %s: %+v", id, err)
+			}
+
+			timespan, err := expandMetricsTimespan(state.Timespan, time.Now())
+			if err != nil {
+				return err
+			}
+
+			options := metrics.ListOperationOptions{
+				Aggregation:        pointer.To(strings.Join(aggregations, ",")),
+				Interval:           pointer.To(state.Interval),
+				Metricnames:        pointer.To(strings.Join(state.MetricNames, ",")),
+				ResultType:         pointer.To(metrics.ResultTypeData),
+				Timespan:           pointer.To(timespan),
+				Top:                pointer.To(state.Top),
+				ValidateDimensions: pointer.To(true),
+			}
+			if state.MetricNamespace != "" {
+				options.Metricnamespace = pointer.To(state.MetricNamespace)
+			}
+			if filter := expandMetricsDimensionFilter(state.DimensionFilter); filter != "" {
+				options.Filter = pointer.To(filter)
+			}
+
+			resp, err := metricsClient.List(ctx, id, options)
+			if err != nil {
+				return fmt.Errorf("retrieving Metrics for %s: %+v", id, err)
+			}
+
+			state.Metric = make([]MetricsMetricModel, 0)
+			if model := resp.Model; model != nil {
+				for _, v := range model.Value {
+					if code := pointer.From(v.ErrorCode); code != "" && !strings.EqualFold(code, "Success") {
+						return fmt.Errorf("retrieving Metric %q for %s: %s: %s", v.Name.Value, id, code, pointer.From(v.ErrorMessage))
+					}
+
+					state.Metric = append(state.Metric, MetricsMetricModel{
+						Name:        v.Name.Value,
+						Unit:        string(v.Unit),
+						Description: pointer.From(v.DisplayDescription),
+						Timeseries:  flattenMetricsTimeseries(v.Timeseries),
+					})
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// validateMetricsQuery checks the requested metrics, aggregations, interval and dimensions against the Metric
+// Definitions for the resource, returning the aggregations to query - which when unspecified are the primary
+// aggregations of the requested metrics
+func validateMetricsQuery(input MetricsDataSourceModel, definitions []metricdefinitions.MetricDefinition) ([]string, error) {
+	definitionsByName := make(map[string]metricdefinitions.MetricDefinition)
+	availableNames := make([]string, 0)
+	for _, v := range definitions {
+		if v.Name == nil || v.Name.Value == "" {
+			continue
+		}
+		definitionsByName[strings.ToLower(v.Name.Value)] = v
+		availableNames = append(availableNames, v.Name.Value)
+	}
+	sort.Strings(availableNames)
+
+	aggregations := input.Aggregations
+	useDefaultAggregations := len(aggregations) == 0
+
+	for _, name := range input.MetricNames {
+		definition, ok := definitionsByName[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("the metric %q was not found, the available metrics are: %s", name, strings.Join(availableNames, ", "))
+		}
+
+		supportedAggregations := make([]string, 0)
+		if definition.SupportedAggregationTypes != nil {
+			for _, v := range *definition.SupportedAggregationTypes {
+				supportedAggregations = append(supportedAggregations, string(v))
+			}
+		}
+
+		if useDefaultAggregations {
+			if v := definition.PrimaryAggregationType; v != nil && *v != metricdefinitions.AggregationTypeNone && !containsFold(aggregations, string(*v)) {
+				aggregations = append(aggregations, string(*v))
+			}
+		} else if len(supportedAggregations) > 0 {
+			for _, aggregation := range aggregations {
+				if !containsFold(supportedAggregations, aggregation) {
+					return nil, fmt.Errorf("the aggregation %q is not supported by the metric %q, the supported aggregations are: %s", aggregation, name, strings.Join(supportedAggregations, ", "))
+				}
+			}
+		}
+
+		if input.Interval != metricsIntervalFull && definition.MetricAvailabilities != nil {
+			intervals := make([]string, 0)
+			for _, v := range *definition.MetricAvailabilities {
+				if v.TimeGrain != nil {
+					intervals = append(intervals, *v.TimeGrain)
+				}
+			}
+			if len(intervals) > 0 && !containsFold(intervals, input.Interval) {
+				return nil, fmt.Errorf("the interval %q is not supported by the metric %q, the supported intervals are: %s", input.Interval, name, strings.Join(intervals, ", "))
+			}
+		}
+
+		dimensions := make([]string, 0)
+		if definition.Dimensions != nil {
+			for _, v := range *definition.Dimensions {
+				dimensions = append(dimensions, v.Value)
+			}
+		}
+		for _, filter := range input.DimensionFilter {
+			if !containsFold(dimensions, filter.Name) {
+				if len(dimensions) == 0 {
+					return nil, fmt.Errorf("the dimension %q was not found, the metric %q has no dimensions", filter.Name, name)
+				}
+				return nil, fmt.Errorf("the dimension %q was not found, the available dimensions for the metric %q are: %s", filter.Name, name, strings.Join(dimensions, ", "))
+			}
+		}
+	}
+
+	if len(aggregations) == 0 {
+		aggregations = append(aggregations, string(metricdefinitions.AggregationTypeAverage))
+	}
+
+	return aggregations, nil
+}
+
+// expandMetricsTimespan converts a relative timespan into the absolute interval required by the API
+func expandMetricsTimespan(input string, now time.Time) (string, error) {
+	if strings.Contains(input, "/") {
+		return input, nil
+	}
+
+	p, err := period.Parse(input)
+	if err != nil {
+		return "", fmt.Errorf("parsing `timespan` %q: %+v", input, err)
+	}
+
+	end := now.UTC().Truncate(time.Minute)
+	start, _ := p.Negate().AddTo(end)
+
+	return fmt.Sprintf("%s/%s", start.Format(time.RFC3339), end.Format(time.RFC3339)), nil
+}
+
+// expandMetricsDimensionFilter builds an OData filter which ANDs each dimension filter together, where a value of
+// `*` splits the results into a timeseries for each value of the dimension
+func expandMetricsDimensionFilter(input []MetricsDimensionFilterModel) string {
+	clauses := make([]string, 0)
+	for _, filter := range input {
+		operator := "eq"
+		joiner := " or "
+		switch filter.Operator {
+		case "Exclude":
+			operator = "ne"
+			joiner = " and "
+		case "StartsWith":
+			operator = "sw"
+		}
+
+		values := make([]string, 0)
+		for _, v := range filter.Values {
+			values = append(values, fmt.Sprintf("%s %s '%s'", filter.Name, operator, strings.ReplaceAll(v, "'", "''")))
+		}
+
+		clauses = append(clauses, fmt.Sprintf("(%s)", strings.Join(values, joiner)))
+	}
+
+	return strings.Join(clauses, " and ")
+}
+
+func flattenMetricsTimeseries(input []metrics.TimeSeriesElement) []MetricsTimeseriesModel {
+	output := make([]MetricsTimeseriesModel, 0)
+	for _, v := range input {
+		dimensions := make(map[string]string)
+		if v.Metadatavalues != nil {
+			for _, metadataValue := range *v.Metadatavalues {
+				if metadataValue.Name == nil {
+					continue
+				}
+				dimensions[metadataValue.Name.Value] = pointer.From(metadataValue.Value)
+			}
+		}
+
+		data := make([]MetricsDataPointModel, 0)
+		if v.Data != nil {
+			for _, point := range *v.Data {
+				// data points without a value for any aggregation are returned when no data was emitted in the interval
+				if point.Average == nil && point.Count == nil && point.Maximum == nil && point.Minimum == nil && point.Total == nil {
+					continue
+				}
+
+				data = append(data, MetricsDataPointModel{
+					Timestamp: point.TimeStamp,
+					Average:   pointer.From(point.Average),
+					Count:     pointer.From(point.Count),
+					Maximum:   pointer.From(point.Maximum),
+					Minimum:   pointer.From(point.Minimum),
+					Total:     pointer.From(point.Total),
+				})
+			}
+		}
+
+		output = append(output, MetricsTimeseriesModel{
+			Dimensions: dimensions,
+			Data:       data,
+		})
+	}
+
+	return output
+}
+
+func containsFold(input []string, value string) bool {
+	for _, v := range input {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MonitorMetricsDataSource struct{}
+
+func TestAccDataSourceMonitorMetrics_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_metrics", "test")
+	r := MonitorMetricsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("metric.#").HasValue("1"),
+				check.That(data.ResourceName).Key("metric.0.name").HasValue("UsedCapacity"),
+				check.That(data.ResourceName).Key("metric.0.unit").HasValue("Bytes"),
+				check.That(data.ResourceName).Key("metric.0.timeseries.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceMonitorMetrics_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_metrics", "test")
+	r := MonitorMetricsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("metric.#").HasValue("2"),
+				check.That(data.ResourceName).Key("metric.0.name").HasValue("Transactions"),
+				check.That(data.ResourceName).Key("metric.1.name").HasValue("Ingress"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceMonitorMetrics_invalidMetricName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_metrics", "test")
+	r := MonitorMetricsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      r.invalidMetricName(data),
+			ExpectError: regexp.MustCompile("the metric \"Transaction\" was not found"),
+		},
+	})
+}
+
+func TestAccDataSourceMonitorMetrics_invalidDimension(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_metrics", "test")
+	r := MonitorMetricsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      r.invalidDimension(data),
+			ExpectError: regexp.MustCompile("the dimension \"Api\" was not found"),
+		},
+	})
+}
+
+func (MonitorMetricsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-monitor-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r MonitorMetricsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_monitor_metrics" "test" {
+  resource_id  = azurerm_storage_account.test.id
+  metric_names = ["UsedCapacity"]
+  interval     = "PT1H"
+  timespan     = "P1D"
+}
+`, r.template(data))
+}
+
+func (r MonitorMetricsDataSource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_monitor_metrics" "test" {
+  resource_id      = azurerm_storage_account.test.id
+  metric_names     = ["Transactions", "Ingress"]
+  metric_namespace = "Microsoft.Storage/storageAccounts"
+  aggregations     = ["Total", "Maximum"]
+  interval         = "FULL"
+  timespan         = "PT6H"
+  top              = 5
+
+  dimension_filter {
+    name   = "ApiName"
+    values = ["*"]
+  }
+
+  dimension_filter {
+    name     = "ResponseType"
+    operator = "Exclude"
+    values   = ["ServerTimeoutError", "ServerOtherError"]
+  }
+}
+`, r.template(data))
+}
+
+func (r MonitorMetricsDataSource) invalidMetricName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_monitor_metrics" "test" {
+  resource_id  = azurerm_storage_account.test.id
+  metric_names = ["Transaction"]
+}
+`, r.template(data))
+}
+
+func (r MonitorMetricsDataSource) invalidDimension(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_monitor_metrics" "test" {
+  resource_id  = azurerm_storage_account.test.id
+  metric_names = ["Transactions"]
+
+  dimension_filter {
+    name   = "Api"
+    values = ["GetBlob"]
+  }
+}
+`, r.template(data))
+}
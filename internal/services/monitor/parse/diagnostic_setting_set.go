@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+// DiagnosticSettingSetId identifies a set of Diagnostic Settings with the same name applied to the resources within a
+// Subscription or Resource Group. As with Diagnostic Settings, this is in the format `{scope}|{name}`.
+type DiagnosticSettingSetId struct {
+	Scope string
+	Name  string
+}
+
+func NewDiagnosticSettingSetID(scope, name string) DiagnosticSettingSetId {
+	return DiagnosticSettingSetId{
+		Scope: scope,
+		Name:  name,
+	}
+}
+
+func (id DiagnosticSettingSetId) ID() string {
+	return fmt.Sprintf("%s|%s", id.Scope, id.Name)
+}
+
+func (id DiagnosticSettingSetId) String() string {
+	return fmt.Sprintf("Diagnostic Setting Set (Scope %q / Name %q)", id.Scope, id.Name)
+}
+
+func DiagnosticSettingSetID(input string) (*DiagnosticSettingSetId, error) {
+	v := strings.Split(input, "|")
+	if len(v) != 2 {
+		return nil, fmt.Errorf("expected the Diagnostic Setting Set ID to be in the format `{scope}|{name}` but got %d segments", len(v))
+	}
+
+	if v[1] == "" {
+		return nil, fmt.Errorf("the name of the Diagnostic Setting Set ID %q was empty", input)
+	}
+
+	if _, err := commonids.ParseResourceGroupID(v[0]); err != nil {
+		if _, err := commonids.ParseSubscriptionID(v[0]); err != nil {
+			return nil, fmt.Errorf("expected the scope of the Diagnostic Setting Set ID to be a Subscription or Resource Group ID but got %q", v[0])
+		}
+	}
+
+	id := NewDiagnosticSettingSetID(v[0], v[1])
+	return &id, nil
+}
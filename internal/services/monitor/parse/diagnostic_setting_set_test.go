@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "testing"
+
+func TestDiagnosticSettingSetID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *DiagnosticSettingSetId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Error: true,
+		},
+		{
+			// empty name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|",
+			Error: true,
+		},
+		{
+			// subscription
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|setting1",
+			Expected: &DiagnosticSettingSetId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012",
+				Name:  "setting1",
+			},
+		},
+		{
+			// resource group
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1|setting1",
+			Expected: &DiagnosticSettingSetId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+				Name:  "setting1",
+			},
+		},
+		{
+			// resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1|setting1",
+			Error: true,
+		},
+		{
+			// too many segments
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|setting1|setting2",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := DiagnosticSettingSetID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID()", v.Input, actual.ID())
+		}
+	}
+}
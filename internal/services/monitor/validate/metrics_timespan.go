@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rickb777/date/period"
+)
+
+// MetricsTimespan validates that the input is either an ISO 8601 duration (e.g. `PT1H`) which is relative to the
+// current time, or an interval in the format `{start}/{end}` where both the start and end are RFC3339 timestamps
+func MetricsTimespan(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !strings.Contains(v, "/") {
+		p, err := period.Parse(v)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("expected %s to be an ISO 8601 duration or an interval in the format `{start}/{end}`: %+v", k, err))
+			return
+		}
+		if p.IsZero() || p.IsNegative() {
+			errors = append(errors, fmt.Errorf("expected %s to be a positive duration, got %q", k, v))
+		}
+		return
+	}
+
+	parts := strings.Split(v, "/")
+	if len(parts) != 2 {
+		errors = append(errors, fmt.Errorf("expected %s to be an interval in the format `{start}/{end}`, got %q", k, v))
+		return
+	}
+
+	start, err := time.Parse(time.RFC3339, parts[0])
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected the start of %s to be an RFC3339 timestamp, got %q", k, parts[0]))
+		return
+	}
+
+	end, err := time.Parse(time.RFC3339, parts[1])
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected the end of %s to be an RFC3339 timestamp, got %q", k, parts[1]))
+		return
+	}
+
+	if !end.After(start) {
+		errors = append(errors, fmt.Errorf("expected the end of %s to be after the start, got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestMetricsTimespan(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "PT1H",
+			expected: true,
+		},
+		{
+			input:    "P1D",
+			expected: true,
+		},
+		{
+			input:    "PT0S",
+			expected: false,
+		},
+		{
+			input:    "1h",
+			expected: false,
+		},
+		{
+			input:    "2024-01-01T00:00:00Z/2024-01-02T00:00:00Z",
+			expected: true,
+		},
+		{
+			input:    "2024-01-01T00:00:00+01:00/2024-01-01T12:00:00+01:00",
+			expected: true,
+		},
+		{
+			input:    "2024-01-02T00:00:00Z/2024-01-01T00:00:00Z",
+			expected: false,
+		},
+		{
+			input:    "2024-01-01T00:00:00Z/PT1H",
+			expected: false,
+		},
+		{
+			input:    "2024-01-01T00:00:00Z/2024-01-02T00:00:00Z/2024-01-03T00:00:00Z",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := MetricsTimespan(v.input, "timespan")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t for %q", v.expected, actual, v.input)
+		}
+	}
+}
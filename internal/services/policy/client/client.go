@@ -9,6 +9,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-sdk/resource-manager/guestconfiguration/2020-06-25/guestconfigurationassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/policyinsights/2021-10-01/remediations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/policyinsights/2024-10-01/policystates"
 	assignments "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2025-01-01/policysetdefinitions"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -18,6 +19,7 @@ type Client struct {
 	AssignmentsClient                   *assignments.PolicyAssignmentsClient
 	GuestConfigurationAssignmentsClient *guestconfigurationassignments.GuestConfigurationAssignmentsClient
 	PolicySetDefinitionsClient          *policysetdefinitions.PolicySetDefinitionsClient
+	PolicyStatesClient                  *policystates.PolicyStatesClient
 	RemediationsClient                  *remediations.RemediationsClient
 
 	// TODO: Migrate these clients to `go-azure-sdk`
@@ -45,6 +47,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(policySetDefinitionsClient.Client, o.Authorizers.ResourceManager)
 
+	policyStatesClient, err := policystates.NewPolicyStatesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Policy States client: %+v", err)
+	}
+	o.Configure(policyStatesClient.Client, o.Authorizers.ResourceManager)
+
 	remediationsClient, err := remediations.NewRemediationsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Remediations client: %+v", err)
@@ -65,6 +73,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		AssignmentsClient:                   assignmentsClient,
 		GuestConfigurationAssignmentsClient: guestConfigurationAssignmentsClient,
 		PolicySetDefinitionsClient:          policySetDefinitionsClient,
+		PolicyStatesClient:                  policyStatesClient,
 		RemediationsClient:                  remediationsClient,
 
 		// Track 1
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PolicyComplianceScanResource struct{}
+
+var _ sdk.Resource = PolicyComplianceScanResource{}
+
+type PolicyComplianceScanResourceModel struct {
+	ScopeId  string            `tfschema:"scope_id"`
+	Triggers map[string]string `tfschema:"triggers"`
+}
+
+func (r PolicyComplianceScanResource) ResourceType() string {
+	return "azurerm_policy_compliance_scan"
+}
+
+func (r PolicyComplianceScanResource) ModelObject() interface{} {
+	return &PolicyComplianceScanResourceModel{}
+}
+
+func (r PolicyComplianceScanResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.Any(
+		commonids.ValidateSubscriptionID,
+		commonids.ValidateResourceGroupID,
+	)
+}
+
+func (r PolicyComplianceScanResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				commonids.ValidateSubscriptionID,
+				commonids.ValidateResourceGroupID,
+			),
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r PolicyComplianceScanResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PolicyComplianceScanResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// a compliance scan of a large subscription can take several hours to complete
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policy.PolicyStatesClient
+
+			var model PolicyComplianceScanResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if id, err := commonids.ParseResourceGroupID(model.ScopeId); err == nil {
+				log.Printf("[DEBUG] Triggering a Policy compliance scan of %s..", *id)
+				if err := client.TriggerResourceGroupEvaluationThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("scanning the Policy compliance of %s: %+v", *id, err)
+				}
+
+				metadata.SetID(id)
+				return metadata.Encode(&model)
+			}
+
+			id, err := commonids.ParseSubscriptionID(model.ScopeId)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] Triggering a Policy compliance scan of %s..", *id)
+			if err := client.TriggerSubscriptionEvaluationThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("scanning the Policy compliance of %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r PolicyComplianceScanResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the scan is a point-in-time operation and there is nothing to read back from the API, the compliance
+			// results can be retrieved using the `azurerm_policy_compliance_summary` data source
+			if _, err := commonids.ParseResourceGroupID(metadata.ResourceData.Id()); err == nil {
+				return nil
+			}
+
+			_, err := commonids.ParseSubscriptionID(metadata.ResourceData.Id())
+			return err
+		},
+	}
+}
+
+func (r PolicyComplianceScanResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// a compliance scan cannot be undone, so this only removes the resource from the state
+			return nil
+		},
+	}
+}
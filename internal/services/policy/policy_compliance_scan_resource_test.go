@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PolicyComplianceScanResource struct{}
+
+func TestAccPolicyComplianceScan_resourceGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_compliance_scan", "test")
+	r := PolicyComplianceScanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceGroup(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.resourceGroup(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("triggers.run").HasValue("second"),
+			),
+		},
+	})
+}
+
+func (r PolicyComplianceScanResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseResourceGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Resource.ResourceGroupsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r PolicyComplianceScanResource) resourceGroup(data acceptance.TestData, run string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-policy-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_policy_compliance_scan" "test" {
+  scope_id = azurerm_resource_group.test.id
+
+  triggers = {
+    run = "%[3]s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, run)
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/policyinsights/2024-10-01/policystates"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PolicyComplianceSummaryDataSource struct{}
+
+var _ sdk.DataSource = PolicyComplianceSummaryDataSource{}
+
+type PolicyComplianceSummaryDataSourceModel struct {
+	ScopeId                   string                              `tfschema:"scope_id"`
+	PolicyAssignmentId        string                              `tfschema:"policy_assignment_id"`
+	CompliantResourceCount    int64                               `tfschema:"compliant_resource_count"`
+	NonCompliantPolicyCount   int64                               `tfschema:"non_compliant_policy_count"`
+	NonCompliantResourceCount int64                               `tfschema:"non_compliant_resource_count"`
+	NonCompliantResourceIds   []string                            `tfschema:"non_compliant_resource_ids"`
+	PolicyAssignments         []PolicyComplianceAssignmentSummary `tfschema:"policy_assignment"`
+}
+
+type PolicyComplianceAssignmentSummary struct {
+	PolicyAssignmentId        string `tfschema:"policy_assignment_id"`
+	PolicySetDefinitionId     string `tfschema:"policy_set_definition_id"`
+	NonCompliantPolicyCount   int64  `tfschema:"non_compliant_policy_count"`
+	NonCompliantResourceCount int64  `tfschema:"non_compliant_resource_count"`
+}
+
+func (PolicyComplianceSummaryDataSource) ResourceType() string {
+	return "azurerm_policy_compliance_summary"
+}
+
+func (PolicyComplianceSummaryDataSource) ModelObject() interface{} {
+	return &PolicyComplianceSummaryDataSourceModel{}
+}
+
+func (PolicyComplianceSummaryDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.Any(
+				commonids.ValidateManagementGroupID,
+				commonids.ValidateSubscriptionID,
+				commonids.ValidateResourceGroupID,
+				azure.ValidateResourceID,
+			),
+		},
+
+		"policy_assignment_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+	}
+}
+
+func (PolicyComplianceSummaryDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"compliant_resource_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"non_compliant_policy_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"non_compliant_resource_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"non_compliant_resource_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"policy_assignment": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"policy_assignment_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"policy_set_definition_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"non_compliant_policy_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"non_compliant_resource_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (PolicyComplianceSummaryDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policy.PolicyStatesClient
+
+			var state PolicyComplianceSummaryDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := policystates.NewScopedPolicyStatesResourceID(state.ScopeId, policystates.PolicyStatesResourceLatest)
+
+			// Policy Insights stores the IDs in lower case and the comparison in the filter is case-sensitive
+			filters := make([]string, 0)
+			if state.PolicyAssignmentId != "" {
+				filters = append(filters, fmt.Sprintf("PolicyAssignmentId eq '%s'", strings.ReplaceAll(strings.ToLower(state.PolicyAssignmentId), "'", "''")))
+			}
+
+			summaryOptions := policystates.DefaultSummarizeForResourceOperationOptions()
+			if len(filters) > 0 {
+				summaryOptions.Filter = pointer.To(strings.Join(filters, " and "))
+			}
+			summary, err := client.SummarizeForResource(ctx, commonids.NewScopeID(state.ScopeId), summaryOptions)
+			if err != nil {
+				return fmt.Errorf("summarizing the Policy compliance of %q: %+v", state.ScopeId, err)
+			}
+
+			state.PolicyAssignments = make([]PolicyComplianceAssignmentSummary, 0)
+			if model := summary.Model; model != nil && model.Value != nil && len(*model.Value) > 0 {
+				v := (*model.Value)[0]
+				if results := v.Results; results != nil {
+					state.NonCompliantPolicyCount = pointer.From(results.NonCompliantPolicies)
+					state.NonCompliantResourceCount = pointer.From(results.NonCompliantResources)
+					for _, detail := range pointer.From(results.ResourceDetails) {
+						if strings.EqualFold(pointer.From(detail.ComplianceState), "compliant") {
+							state.CompliantResourceCount = pointer.From(detail.Count)
+						}
+					}
+				}
+				for _, assignment := range pointer.From(v.PolicyAssignments) {
+					item := PolicyComplianceAssignmentSummary{
+						PolicyAssignmentId:    pointer.From(assignment.PolicyAssignmentId),
+						PolicySetDefinitionId: pointer.From(assignment.PolicySetDefinitionId),
+					}
+					if results := assignment.Results; results != nil {
+						item.NonCompliantPolicyCount = pointer.From(results.NonCompliantPolicies)
+						item.NonCompliantResourceCount = pointer.From(results.NonCompliantResources)
+					}
+					state.PolicyAssignments = append(state.PolicyAssignments, item)
+				}
+			}
+
+			// the resource IDs are grouped by the API since a resource is reported once for each Policy it doesn't comply with
+			listOptions := policystates.DefaultListQueryResultsForResourceOperationOptions()
+			listOptions.Filter = pointer.To(strings.Join(append(filters, "ComplianceState eq 'NonCompliant'"), " and "))
+			listOptions.Apply = pointer.To("groupby((ResourceId))")
+			results, err := client.ListQueryResultsForResourceComplete(ctx, id, listOptions)
+			if err != nil {
+				return fmt.Errorf("listing the non-compliant resources of %q: %+v", state.ScopeId, err)
+			}
+
+			resourceIds := make(map[string]struct{})
+			for _, v := range results.Items {
+				if v.ResourceId != nil {
+					resourceIds[*v.ResourceId] = struct{}{}
+				}
+			}
+			state.NonCompliantResourceIds = make([]string, 0, len(resourceIds))
+			for v := range resourceIds {
+				state.NonCompliantResourceIds = append(state.NonCompliantResourceIds, v)
+			}
+			sort.Strings(state.NonCompliantResourceIds)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
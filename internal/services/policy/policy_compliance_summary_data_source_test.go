@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PolicyComplianceSummaryDataSource struct{}
+
+func TestAccDataSourcePolicyComplianceSummary_resourceGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_policy_compliance_summary", "test")
+	d := PolicyComplianceSummaryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.resourceGroup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("non_compliant_resource_count").HasValue("1"),
+				check.That(data.ResourceName).Key("non_compliant_policy_count").HasValue("1"),
+				check.That(data.ResourceName).Key("non_compliant_resource_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("policy_assignment.#").HasValue("1"),
+				check.That(data.ResourceName).Key("policy_assignment.0.non_compliant_resource_count").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourcePolicyComplianceSummary_policyAssignment(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_policy_compliance_summary", "test")
+	d := PolicyComplianceSummaryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.policyAssignment(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("non_compliant_resource_count").HasValue("1"),
+				check.That(data.ResourceName).Key("non_compliant_resource_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("policy_assignment.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (d PolicyComplianceSummaryDataSource) resourceGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_policy_compliance_summary" "test" {
+  scope_id = azurerm_resource_group.test.id
+
+  depends_on = [azurerm_policy_compliance_scan.test]
+}
+`, d.template(data))
+}
+
+func (d PolicyComplianceSummaryDataSource) policyAssignment(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_policy_compliance_summary" "test" {
+  scope_id             = azurerm_resource_group.test.id
+  policy_assignment_id = azurerm_resource_group_policy_assignment.test.id
+
+  depends_on = [azurerm_policy_compliance_scan.test]
+}
+`, d.template(data))
+}
+
+func (d PolicyComplianceSummaryDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-policy-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestpol-%[1]d"
+  policy_type  = "Custom"
+  mode         = "Indexed"
+  display_name = "acctestpol-%[1]d"
+
+  policy_rule = jsonencode({
+    if = {
+      allOf = [
+        {
+          field  = "type"
+          equals = "Microsoft.Storage/storageAccounts"
+        },
+        {
+          field  = "Microsoft.Storage/storageAccounts/supportsHttpsTrafficOnly"
+          equals = "false"
+        },
+      ]
+    }
+    then = {
+      effect = "audit"
+    }
+  })
+}
+
+resource "azurerm_resource_group_policy_assignment" "test" {
+  name                 = "acctestpa-%[1]d"
+  resource_group_id    = azurerm_resource_group.test.id
+  policy_definition_id = azurerm_policy_definition.test.id
+}
+
+resource "azurerm_storage_account" "test" {
+  name                       = "acctestsa%[3]s"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  account_tier               = "Standard"
+  account_replication_type   = "LRS"
+  https_traffic_only_enabled = false
+}
+
+resource "azurerm_policy_compliance_scan" "test" {
+  scope_id = azurerm_resource_group.test.id
+
+  depends_on = [
+    azurerm_resource_group_policy_assignment.test,
+    azurerm_storage_account.test,
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -23,6 +23,7 @@ type Registration struct{}
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		AssignmentDataSource{},
+		PolicyComplianceSummaryDataSource{},
 	}
 }
 
@@ -30,6 +31,7 @@ func (r Registration) Resources() []sdk.Resource {
 	resources := []sdk.Resource{
 		ManagementGroupAssignmentResource{},
 		ManagementGroupPolicySetDefinitionResource{},
+		PolicyComplianceScanResource{},
 		ResourceAssignmentResource{},
 		ResourceGroupAssignmentResource{},
 		SubscriptionAssignmentResource{},
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package portal
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// suppressPortalDashboardPropertiesDiff compares the semantic content of the Dashboard Properties, since the Portal
+// renumbers the Lenses and Parts when the Dashboard is saved and the JSON is otherwise compared as a string
+func suppressPortalDashboardPropertiesDiff(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldNormalized, err := normalizePortalDashboardProperties(old)
+	if err != nil {
+		return false
+	}
+	newNormalized, err := normalizePortalDashboardProperties(new)
+	if err != nil {
+		return false
+	}
+
+	return oldNormalized == newNormalized
+}
+
+// normalizePortalDashboardProperties returns the Dashboard Properties with the Lenses ordered by their `order` and the
+// Parts within each Lens ordered by their position, each keyed by its index. The `metadata` of each position is
+// removed, since it's only used by the Portal when laying out the Parts.
+func normalizePortalDashboardProperties(input string) (string, error) {
+	var properties map[string]interface{}
+	if err := json.Unmarshal([]byte(input), &properties); err != nil {
+		return "", fmt.Errorf("parsing JSON: %+v", err)
+	}
+
+	if raw, ok := properties["lenses"]; ok && raw != nil {
+		lenses := portalDashboardCollectionValues(raw)
+		sort.SliceStable(lenses, func(i, j int) bool {
+			return portalDashboardInt(lenses[i], "order") < portalDashboardInt(lenses[j], "order")
+		})
+
+		normalizedLenses := make(map[string]interface{}, len(lenses))
+		for i, v := range lenses {
+			lens, ok := v.(map[string]interface{})
+			if !ok {
+				normalizedLenses[strconv.Itoa(i)] = v
+				continue
+			}
+
+			if rawParts, ok := lens["parts"]; ok && rawParts != nil {
+				parts := portalDashboardCollectionValues(rawParts)
+				for _, p := range parts {
+					if part, ok := p.(map[string]interface{}); ok {
+						if position, ok := part["position"].(map[string]interface{}); ok {
+							delete(position, "metadata")
+						}
+					}
+				}
+
+				sort.SliceStable(parts, func(i, j int) bool {
+					return portalDashboardPartLess(parts[i], parts[j])
+				})
+
+				normalizedParts := make(map[string]interface{}, len(parts))
+				for i, part := range parts {
+					normalizedParts[strconv.Itoa(i)] = part
+				}
+				lens["parts"] = normalizedParts
+			}
+
+			normalizedLenses[strconv.Itoa(i)] = lens
+		}
+		properties["lenses"] = normalizedLenses
+	}
+
+	output, err := json.Marshal(properties)
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+// portalDashboardCollectionValues returns the values of a collection of Lenses or Parts, which can be specified either
+// as an object keyed by the index or as an array, ordered by the key so that the result is deterministic
+func portalDashboardCollectionValues(input interface{}) []interface{} {
+	switch v := input.(type) {
+	case []interface{}:
+		return v
+
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			a, aErr := strconv.Atoi(keys[i])
+			b, bErr := strconv.Atoi(keys[j])
+			if aErr == nil && bErr == nil {
+				return a < b
+			}
+			return keys[i] < keys[j]
+		})
+
+		output := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			output = append(output, v[key])
+		}
+		return output
+	}
+
+	return []interface{}{}
+}
+
+func portalDashboardPartLess(a, b interface{}) bool {
+	aPart, _ := a.(map[string]interface{})
+	bPart, _ := b.(map[string]interface{})
+	aPosition, _ := aPart["position"].(map[string]interface{})
+	bPosition, _ := bPart["position"].(map[string]interface{})
+
+	for _, key := range []string{"y", "x", "rowSpan", "colSpan"} {
+		if av, bv := portalDashboardInt(aPosition, key), portalDashboardInt(bPosition, key); av != bv {
+			return av < bv
+		}
+	}
+
+	// Parts sharing a position are ordered by their content so that the result doesn't depend on the input order
+	aJson, _ := json.Marshal(a)
+	bJson, _ := json.Marshal(b)
+	return string(aJson) < string(bJson)
+}
+
+func portalDashboardInt(input interface{}, key string) int64 {
+	values, ok := input.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+
+	switch v := values[key].(type) {
+	case float64:
+		return int64(v)
+	case string:
+		i, _ := strconv.ParseInt(v, 10, 64)
+		return i
+	}
+
+	return 0
+}
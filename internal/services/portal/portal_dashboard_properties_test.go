@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package portal
+
+import (
+	"testing"
+)
+
+func TestSuppressPortalDashboardPropertiesDiff(t *testing.T) {
+	testData := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "identical",
+			old:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":3,"rowSpan":2}}}}}}`,
+			new:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":3,"rowSpan":2}}}}}}`,
+			expected: true,
+		},
+		{
+			name: "whitespace and key ordering",
+			old:  `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":3,"rowSpan":2}}}}}}`,
+			new: `{
+  "lenses": {
+    "0": {
+      "parts": {
+        "0": {
+          "position": { "rowSpan": 2, "colSpan": 3, "y": 0, "x": 0 }
+        }
+      },
+      "order": 0
+    }
+  }
+}`,
+			expected: true,
+		},
+		{
+			name:     "renumbered parts",
+			old:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":3,"rowSpan":2},"metadata":{"type":"a"}},"1":{"position":{"x":3,"y":0,"colSpan":3,"rowSpan":2},"metadata":{"type":"b"}}}}}}`,
+			new:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":3,"y":0,"colSpan":3,"rowSpan":2},"metadata":{"type":"b"}},"1":{"position":{"x":0,"y":0,"colSpan":3,"rowSpan":2},"metadata":{"type":"a"}}}}}}`,
+			expected: true,
+		},
+		{
+			name:     "renumbered lenses",
+			old:      `{"lenses":{"0":{"order":0,"parts":{}},"1":{"order":1,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":1,"rowSpan":1}}}}}}`,
+			new:      `{"lenses":{"a":{"order":1,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":1,"rowSpan":1}}}},"b":{"order":0,"parts":{}}}}`,
+			expected: true,
+		},
+		{
+			name:     "position metadata",
+			old:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":3,"rowSpan":2,"metadata":{"layout":"auto"}}}}}}}`,
+			new:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":3,"rowSpan":2}}}}}}`,
+			expected: true,
+		},
+		{
+			name:     "moved part",
+			old:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":3,"rowSpan":2}}}}}}`,
+			new:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":3,"y":0,"colSpan":3,"rowSpan":2}}}}}}`,
+			expected: false,
+		},
+		{
+			name:     "changed part",
+			old:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":3,"rowSpan":2},"metadata":{"type":"a"}}}}}}`,
+			new:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":3,"rowSpan":2},"metadata":{"type":"b"}}}}}}`,
+			expected: false,
+		},
+		{
+			name:     "changed metadata",
+			old:      `{"lenses":{},"metadata":{"model":{"timeRange":{"value":{"relative":{"duration":24}}}}}}`,
+			new:      `{"lenses":{},"metadata":{"model":{"timeRange":{"value":{"relative":{"duration":48}}}}}}`,
+			expected: false,
+		},
+		{
+			name:     "invalid",
+			old:      `{"lenses":{}}`,
+			new:      `{"lenses":`,
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := suppressPortalDashboardPropertiesDiff("dashboard_properties", v.old, v.new, nil)
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
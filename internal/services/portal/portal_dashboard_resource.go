@@ -4,6 +4,7 @@
 package portal
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -57,12 +58,25 @@ func resourcePortalDashboard() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 
 			"dashboard_properties": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: validate.DashboardProperties,
-				StateFunc:    utils.NormalizeJson,
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"dashboard_properties", "tile"},
+				ValidateFunc:     validate.DashboardProperties,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: suppressPortalDashboardPropertiesDiff,
 			},
+
+			"tile": portalDashboardTileSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+			// the Dashboard Properties are generated from the tiles, and so will change when they do
+			if d.HasChange("tile") && len(d.Get("tile").([]interface{})) > 0 {
+				return d.SetNewComputed("dashboard_properties")
+			}
+			return nil
+		}),
 	}
 }
 
@@ -91,14 +105,22 @@ func resourcePortalDashboardCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	var dashboardProperties dashboard.DashboardProperties
+	if tiles := d.Get("tile").([]interface{}); len(tiles) > 0 {
+		dashboardProperties, err := expandPortalDashboardTiles(tiles)
+		if err != nil {
+			return err
+		}
+		props.Properties = dashboardProperties
+	} else {
+		var dashboardProperties dashboard.DashboardProperties
 
-	dashboardPropsRaw := d.Get("dashboard_properties").(string)
-	if err := json.Unmarshal([]byte(dashboardPropsRaw), &dashboardProperties); err != nil {
-		return fmt.Errorf("parsing JSON: %+v", err)
-	}
+		dashboardPropsRaw := d.Get("dashboard_properties").(string)
+		if err := json.Unmarshal([]byte(dashboardPropsRaw), &dashboardProperties); err != nil {
+			return fmt.Errorf("parsing JSON: %+v", err)
+		}
 
-	props.Properties = &dashboardProperties
+		props.Properties = &dashboardProperties
+	}
 
 	if _, err := client.CreateOrUpdate(ctx, id, props); err != nil {
 		return fmt.Errorf("creating/updating %s %+v", id, err)
@@ -140,6 +162,13 @@ func resourcePortalDashboardRead(d *pluginsdk.ResourceData, meta interface{}) er
 				return fmt.Errorf("parsing JSON for Dashboard Properties: %+v", err)
 			}
 			d.Set("dashboard_properties", string(v))
+
+			// the tiles are only set when they're used to define the Dashboard, since they can't represent every Part
+			if len(d.Get("tile").([]interface{})) > 0 {
+				if err := d.Set("tile", flattenPortalDashboardTiles(props)); err != nil {
+					return fmt.Errorf("setting `tile`: %+v", err)
+				}
+			}
 		}
 
 		return tags.FlattenAndSet(d, model.Tags)
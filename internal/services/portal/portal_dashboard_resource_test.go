@@ -60,6 +60,48 @@ func TestAccPortalDashboard_hiddenTitle(t *testing.T) {
 	})
 }
 
+func TestAccPortalDashboard_tiles(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_portal_dashboard", "test")
+	r := PortalDashboardResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.tiles(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tile.#").HasValue("3"),
+				check.That(data.ResourceName).Key("dashboard_properties").Exists(),
+			),
+		},
+		data.ImportStep("tile"),
+		{
+			Config: r.tilesUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tile.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("tile"),
+	})
+}
+
+func TestAccPortalDashboard_reorderedParts(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_portal_dashboard", "test")
+	r := PortalDashboardResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.reorderedParts(data, "0", "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:   r.reorderedParts(data, "1", "0"),
+			PlanOnly: true,
+		},
+		data.ImportStep(),
+	})
+}
+
 func (PortalDashboardResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := dashboard.ParseDashboardID(state.ID)
 	if err != nil {
@@ -207,3 +249,171 @@ DASH
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (PortalDashboardResource) tilesTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+locals {
+  workspaces = [azurerm_log_analytics_workspace.test.id]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r PortalDashboardResource) tiles(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_portal_dashboard" "test" {
+  name                = "my-test-dashboard"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  tile {
+    x        = 0
+    y        = 0
+    col_span = 12
+    row_span = 2
+
+    markdown {
+      content = "## This is only a test :)"
+      title   = "Test MD Tile"
+    }
+  }
+
+  dynamic "tile" {
+    for_each = local.workspaces
+    content {
+      x = tile.key * 6
+      y = 2
+
+      metrics_chart {
+        resource_id      = tile.value
+        metric_namespace = "microsoft.operationalinsights/workspaces"
+        metric_name      = "Heartbeat"
+        aggregation      = "Count"
+        time_range       = "PT24H"
+      }
+    }
+  }
+
+  dynamic "tile" {
+    for_each = local.workspaces
+    content {
+      x = 6
+      y = 2 + tile.key * 4
+
+      log_query {
+        workspace_id  = tile.value
+        query         = "Heartbeat | summarize count() by bin(TimeGenerated, 1h)"
+        title         = "Heartbeats"
+        visualization = "line"
+      }
+    }
+  }
+}
+`, r.tilesTemplate(data))
+}
+
+func (r PortalDashboardResource) tilesUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_portal_dashboard" "test" {
+  name                = "my-test-dashboard"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  tile {
+    x        = 0
+    y        = 0
+    col_span = 12
+    row_span = 2
+
+    markdown {
+      content  = "## This is an updated test :)"
+      title    = "Test MD Tile"
+      subtitle = "Updated"
+    }
+  }
+
+  tile {
+    x        = 0
+    y        = 2
+    col_span = 12
+
+    log_query {
+      workspace_id = azurerm_log_analytics_workspace.test.id
+      query        = "Heartbeat | take 10"
+      time_range   = "PT4H"
+    }
+  }
+}
+`, r.tilesTemplate(data))
+}
+
+func (PortalDashboardResource) reorderedParts(data acceptance.TestData, first string, second string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_portal_dashboard" "test" {
+  name                = "my-test-dashboard"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  dashboard_properties = jsonencode({
+    lenses = {
+      "0" = {
+        order = 0
+        parts = {
+          %[3]q = {
+            position = { x = 0, y = 0, rowSpan = 2, colSpan = 3 }
+            metadata = {
+              inputs = []
+              type   = "Extension/HubsExtension/PartType/MarkdownPart"
+              settings = {
+                content = {
+                  settings = { content = "First", subtitle = "", title = "" }
+                }
+              }
+            }
+          }
+          %[4]q = {
+            position = { x = 3, y = 0, rowSpan = 2, colSpan = 3 }
+            metadata = {
+              inputs = []
+              type   = "Extension/HubsExtension/PartType/MarkdownPart"
+              settings = {
+                content = {
+                  settings = { content = "Second", subtitle = "", title = "" }
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  })
+}
+`, data.RandomInteger, data.Locations.Primary, first, second)
+}
@@ -0,0 +1,623 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package portal
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/portal/2019-01-01-preview/dashboard"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/rickb777/date/period"
+)
+
+const (
+	portalDashboardPartTypeMarkdown     = "Extension/HubsExtension/PartType/MarkdownPart"
+	portalDashboardPartTypeMetricsChart = "Extension/HubsExtension/PartType/MonitorChartPart"
+	portalDashboardPartTypeLogQuery     = "Extension/Microsoft_OperationsManagementSuite_Workspace/PartType/LogsDashboardPart"
+)
+
+// the Portal identifies the aggregation and chart type of a Metrics Chart using the numeric values of these enums
+var (
+	portalDashboardMetricsAggregations = map[string]int64{
+		"Total":   1,
+		"Minimum": 2,
+		"Maximum": 3,
+		"Average": 4,
+		"Count":   7,
+	}
+
+	portalDashboardMetricsChartTypes = map[string]int64{
+		"Bar":     1,
+		"Line":    2,
+		"Area":    3,
+		"Scatter": 4,
+	}
+
+	// the Log Query visualizations map to a `ControlType` and, for charts, a `SpecificChart`
+	portalDashboardLogQueryCharts = map[string]string{
+		"bar":  "StackedColumn",
+		"line": "Line",
+		"pie":  "Pie",
+	}
+)
+
+func portalDashboardTileSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{"dashboard_properties"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"x": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
+				"y": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
+				"col_span": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      6,
+					ValidateFunc: validation.IntBetween(1, 12),
+				},
+
+				"row_span": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      4,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"markdown": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"content": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"title": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+							},
+
+							"subtitle": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+
+				"metrics_chart": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"resource_id": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+
+							"metric_namespace": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"metric_name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"aggregation": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								Default:      "Average",
+								ValidateFunc: validation.StringInSlice(portalDashboardSortedKeys(portalDashboardMetricsAggregations), false),
+							},
+
+							"chart_type": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								Default:      "Line",
+								ValidateFunc: validation.StringInSlice(portalDashboardSortedKeys(portalDashboardMetricsChartTypes), false),
+							},
+
+							"time_range": {
+								Type:             pluginsdk.TypeString,
+								Optional:         true,
+								Default:          "P1D",
+								ValidateFunc:     validate.ISO8601DurationBetween("PT30M", "P30D"),
+								DiffSuppressFunc: suppressPortalDashboardDurationDiff,
+							},
+
+							"title": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+
+				"log_query": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"workspace_id": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: workspaces.ValidateWorkspaceID,
+							},
+
+							"query": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"time_range": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								Default:      "P1D",
+								ValidateFunc: validate.ISO8601Duration,
+							},
+
+							"title": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+							},
+
+							"subtitle": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+							},
+
+							"visualization": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								Default:  "table",
+								ValidateFunc: validation.StringInSlice([]string{
+									"bar",
+									"line",
+									"pie",
+									"table",
+								}, false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandPortalDashboardTiles(input []interface{}) (*dashboard.DashboardProperties, error) {
+	parts := make(map[string]dashboard.DashboardParts, len(input))
+	for i, raw := range input {
+		if raw == nil {
+			continue
+		}
+		tile := raw.(map[string]interface{})
+
+		markdown := tile["markdown"].([]interface{})
+		metricsChart := tile["metrics_chart"].([]interface{})
+		logQuery := tile["log_query"].([]interface{})
+
+		var metadata map[string]interface{}
+		var err error
+		switch {
+		case len(markdown) > 0 && len(metricsChart) == 0 && len(logQuery) == 0:
+			metadata = expandPortalDashboardMarkdownPart(markdown)
+		case len(metricsChart) > 0 && len(markdown) == 0 && len(logQuery) == 0:
+			metadata, err = expandPortalDashboardMetricsChartPart(metricsChart)
+		case len(logQuery) > 0 && len(markdown) == 0 && len(metricsChart) == 0:
+			metadata = expandPortalDashboardLogQueryPart(logQuery)
+		default:
+			return nil, fmt.Errorf("exactly one of `markdown`, `metrics_chart` or `log_query` must be specified for `tile.%d`", i)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("expanding `tile.%d`: %+v", i, err)
+		}
+
+		var partMetadata interface{} = metadata
+		parts[fmt.Sprintf("%d", len(parts))] = dashboard.DashboardParts{
+			Position: dashboard.DashboardPartsPosition{
+				X:       int64(tile["x"].(int)),
+				Y:       int64(tile["y"].(int)),
+				ColSpan: int64(tile["col_span"].(int)),
+				RowSpan: int64(tile["row_span"].(int)),
+			},
+			Metadata: &partMetadata,
+		}
+	}
+
+	return &dashboard.DashboardProperties{
+		Lenses: &map[string]dashboard.DashboardLens{
+			"0": {
+				Order: 0,
+				Parts: parts,
+			},
+		},
+	}, nil
+}
+
+func expandPortalDashboardMarkdownPart(input []interface{}) map[string]interface{} {
+	markdown := input[0].(map[string]interface{})
+
+	return map[string]interface{}{
+		"type":   portalDashboardPartTypeMarkdown,
+		"inputs": []interface{}{},
+		"settings": map[string]interface{}{
+			"content": map[string]interface{}{
+				"settings": map[string]interface{}{
+					"content":  markdown["content"].(string),
+					"title":    markdown["title"].(string),
+					"subtitle": markdown["subtitle"].(string),
+				},
+			},
+		},
+	}
+}
+
+func expandPortalDashboardMetricsChartPart(input []interface{}) (map[string]interface{}, error) {
+	chart := input[0].(map[string]interface{})
+
+	timeRange, err := period.Parse(chart["time_range"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `time_range`: %+v", err)
+	}
+
+	metricName := chart["metric_name"].(string)
+	title := chart["title"].(string)
+	if title == "" {
+		title = metricName
+	}
+
+	return map[string]interface{}{
+		"type": portalDashboardPartTypeMetricsChart,
+		"inputs": []interface{}{
+			map[string]interface{}{
+				"name": "options",
+				"value": map[string]interface{}{
+					"chart": map[string]interface{}{
+						"metrics": []interface{}{
+							map[string]interface{}{
+								"resourceMetadata": map[string]interface{}{
+									"id": chart["resource_id"].(string),
+								},
+								"name":            metricName,
+								"namespace":       chart["metric_namespace"].(string),
+								"aggregationType": portalDashboardMetricsAggregations[chart["aggregation"].(string)],
+								"metricVisualization": map[string]interface{}{
+									"displayName": metricName,
+								},
+							},
+						},
+						"title":     title,
+						"titleKind": 2,
+						"visualization": map[string]interface{}{
+							"chartType": portalDashboardMetricsChartTypes[chart["chart_type"].(string)],
+						},
+						"timespan": map[string]interface{}{
+							"relative": map[string]interface{}{
+								"duration": timeRange.DurationApprox().Milliseconds(),
+							},
+						},
+					},
+				},
+			},
+			map[string]interface{}{
+				"name":       "sharedTimeRange",
+				"isOptional": true,
+			},
+		},
+		"settings": map[string]interface{}{},
+	}, nil
+}
+
+func expandPortalDashboardLogQueryPart(input []interface{}) map[string]interface{} {
+	query := input[0].(map[string]interface{})
+
+	controlType := "AnalyticsGrid"
+	specificChart, isChart := portalDashboardLogQueryCharts[query["visualization"].(string)]
+	if isChart {
+		controlType = "FrameControlChart"
+	}
+
+	inputs := []interface{}{
+		map[string]interface{}{
+			"name": "Scope",
+			"value": map[string]interface{}{
+				"resourceIds": []interface{}{
+					query["workspace_id"].(string),
+				},
+			},
+			"isOptional": true,
+		},
+		map[string]interface{}{
+			"name":       "Version",
+			"value":      "2.0",
+			"isOptional": true,
+		},
+		map[string]interface{}{
+			"name":       "TimeRange",
+			"value":      query["time_range"].(string),
+			"isOptional": true,
+		},
+		map[string]interface{}{
+			"name":       "Query",
+			"value":      query["query"].(string),
+			"isOptional": true,
+		},
+		map[string]interface{}{
+			"name":       "ControlType",
+			"value":      controlType,
+			"isOptional": true,
+		},
+		map[string]interface{}{
+			"name":       "PartTitle",
+			"value":      query["title"].(string),
+			"isOptional": true,
+		},
+		map[string]interface{}{
+			"name":       "PartSubTitle",
+			"value":      query["subtitle"].(string),
+			"isOptional": true,
+		},
+		map[string]interface{}{
+			"name":       "IsQueryContainTimeRange",
+			"value":      false,
+			"isOptional": true,
+		},
+	}
+	if isChart {
+		inputs = append(inputs, map[string]interface{}{
+			"name":       "SpecificChart",
+			"value":      specificChart,
+			"isOptional": true,
+		})
+	}
+
+	return map[string]interface{}{
+		"type":     portalDashboardPartTypeLogQuery,
+		"inputs":   inputs,
+		"settings": map[string]interface{}{},
+	}
+}
+
+// flattenPortalDashboardTiles returns the Parts of the first Lens which can be represented as a `tile`, ordered by
+// their position. Any other Parts are omitted, which will show as a diff when the Dashboard has been modified in the Portal.
+func flattenPortalDashboardTiles(input *dashboard.DashboardProperties) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil || input.Lenses == nil {
+		return output
+	}
+
+	var lens *dashboard.DashboardLens
+	for _, v := range *input.Lenses {
+		if lens == nil || v.Order < lens.Order {
+			l := v
+			lens = &l
+		}
+	}
+	if lens == nil {
+		return output
+	}
+
+	parts := make([]dashboard.DashboardParts, 0, len(lens.Parts))
+	for _, part := range lens.Parts {
+		parts = append(parts, part)
+	}
+	sort.SliceStable(parts, func(i, j int) bool {
+		if parts[i].Position.Y != parts[j].Position.Y {
+			return parts[i].Position.Y < parts[j].Position.Y
+		}
+		return parts[i].Position.X < parts[j].Position.X
+	})
+
+	for _, part := range parts {
+		if part.Metadata == nil {
+			continue
+		}
+		metadata, ok := (*part.Metadata).(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		tile := map[string]interface{}{
+			"x":             int(part.Position.X),
+			"y":             int(part.Position.Y),
+			"col_span":      int(part.Position.ColSpan),
+			"row_span":      int(part.Position.RowSpan),
+			"markdown":      []interface{}{},
+			"metrics_chart": []interface{}{},
+			"log_query":     []interface{}{},
+		}
+
+		partType, _ := metadata["type"].(string)
+		switch partType {
+		case portalDashboardPartTypeMarkdown:
+			tile["markdown"] = flattenPortalDashboardMarkdownPart(metadata)
+		case portalDashboardPartTypeMetricsChart:
+			tile["metrics_chart"] = flattenPortalDashboardMetricsChartPart(metadata)
+		case portalDashboardPartTypeLogQuery:
+			tile["log_query"] = flattenPortalDashboardLogQueryPart(metadata)
+		default:
+			continue
+		}
+
+		output = append(output, tile)
+	}
+
+	return output
+}
+
+func flattenPortalDashboardMarkdownPart(input map[string]interface{}) []interface{} {
+	settings := portalDashboardMap(portalDashboardMap(portalDashboardMap(input, "settings"), "content"), "settings")
+
+	return []interface{}{
+		map[string]interface{}{
+			"content":  portalDashboardString(settings, "content"),
+			"title":    portalDashboardString(settings, "title"),
+			"subtitle": portalDashboardString(settings, "subtitle"),
+		},
+	}
+}
+
+func flattenPortalDashboardMetricsChartPart(input map[string]interface{}) []interface{} {
+	chart := portalDashboardMap(portalDashboardMap(portalDashboardInputs(input)["options"], "value"), "chart")
+
+	output := map[string]interface{}{
+		"title": portalDashboardString(chart, "title"),
+	}
+
+	if metrics, ok := chart["metrics"].([]interface{}); ok && len(metrics) > 0 {
+		metric, _ := metrics[0].(map[string]interface{})
+		output["resource_id"] = portalDashboardString(portalDashboardMap(metric, "resourceMetadata"), "id")
+		output["metric_name"] = portalDashboardString(metric, "name")
+		output["metric_namespace"] = portalDashboardString(metric, "namespace")
+
+		aggregationType := portalDashboardInt(metric, "aggregationType")
+		for k, v := range portalDashboardMetricsAggregations {
+			if v == aggregationType {
+				output["aggregation"] = k
+			}
+		}
+
+		// the title defaults to the name of the metric
+		if output["title"] == output["metric_name"] {
+			output["title"] = ""
+		}
+	}
+
+	chartType := portalDashboardInt(portalDashboardMap(chart, "visualization"), "chartType")
+	for k, v := range portalDashboardMetricsChartTypes {
+		if v == chartType {
+			output["chart_type"] = k
+		}
+	}
+
+	if duration := portalDashboardInt(portalDashboardMap(portalDashboardMap(chart, "timespan"), "relative"), "duration"); duration > 0 {
+		output["time_range"] = flattenPortalDashboardDuration(time.Duration(duration) * time.Millisecond)
+	}
+
+	return []interface{}{output}
+}
+
+func flattenPortalDashboardLogQueryPart(input map[string]interface{}) []interface{} {
+	inputs := portalDashboardInputs(input)
+
+	output := map[string]interface{}{
+		"query":         portalDashboardString(inputs["Query"], "value"),
+		"time_range":    portalDashboardString(inputs["TimeRange"], "value"),
+		"title":         portalDashboardString(inputs["PartTitle"], "value"),
+		"subtitle":      portalDashboardString(inputs["PartSubTitle"], "value"),
+		"visualization": "table",
+	}
+
+	if resourceIds, ok := portalDashboardMap(inputs["Scope"], "value")["resourceIds"].([]interface{}); ok && len(resourceIds) > 0 {
+		if v, ok := resourceIds[0].(string); ok {
+			output["workspace_id"] = v
+		}
+	}
+
+	if portalDashboardString(inputs["ControlType"], "value") == "FrameControlChart" {
+		specificChart := portalDashboardString(inputs["SpecificChart"], "value")
+		for k, v := range portalDashboardLogQueryCharts {
+			if strings.EqualFold(v, specificChart) {
+				output["visualization"] = k
+			}
+		}
+	}
+
+	return []interface{}{output}
+}
+
+// flattenPortalDashboardDuration returns the ISO 8601 representation of the duration using the largest whole unit
+func flattenPortalDashboardDuration(input time.Duration) string {
+	switch {
+	case input%(24*time.Hour) == 0:
+		return fmt.Sprintf("P%dD", input/(24*time.Hour))
+	case input%time.Hour == 0:
+		return fmt.Sprintf("PT%dH", input/time.Hour)
+	default:
+		return fmt.Sprintf("PT%dM", input/time.Minute)
+	}
+}
+
+func suppressPortalDashboardDurationDiff(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	oldPeriod, err := period.Parse(old)
+	if err != nil {
+		return false
+	}
+	newPeriod, err := period.Parse(new)
+	if err != nil {
+		return false
+	}
+
+	return oldPeriod.DurationApprox() == newPeriod.DurationApprox()
+}
+
+// portalDashboardInputs returns the `inputs` of a Part keyed by their name
+func portalDashboardInputs(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	if inputs, ok := input["inputs"].([]interface{}); ok {
+		for _, v := range inputs {
+			if item, ok := v.(map[string]interface{}); ok {
+				output[portalDashboardString(item, "name")] = item
+			}
+		}
+	}
+	return output
+}
+
+func portalDashboardMap(input interface{}, key string) map[string]interface{} {
+	if values, ok := input.(map[string]interface{}); ok {
+		if v, ok := values[key].(map[string]interface{}); ok {
+			return v
+		}
+	}
+	return map[string]interface{}{}
+}
+
+func portalDashboardString(input interface{}, key string) string {
+	if values, ok := input.(map[string]interface{}); ok {
+		if v, ok := values[key].(string); ok {
+			return v
+		}
+	}
+	return ""
+}
+
+func portalDashboardSortedKeys(input map[string]int64) []string {
+	output := make([]string, 0, len(input))
+	for k := range input {
+		output = append(output, k)
+	}
+	sort.Strings(output)
+	return output
+}
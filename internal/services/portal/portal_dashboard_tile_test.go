@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package portal
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/portal/2019-01-01-preview/dashboard"
+)
+
+func TestPortalDashboardTilesRoundTrip(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"x":        0,
+			"y":        0,
+			"col_span": 6,
+			"row_span": 2,
+			"markdown": []interface{}{
+				map[string]interface{}{
+					"content":  "## Hello",
+					"title":    "Notes",
+					"subtitle": "",
+				},
+			},
+			"metrics_chart": []interface{}{},
+			"log_query":     []interface{}{},
+		},
+		map[string]interface{}{
+			"x":        6,
+			"y":        0,
+			"col_span": 6,
+			"row_span": 4,
+			"markdown": []interface{}{},
+			"metrics_chart": []interface{}{
+				map[string]interface{}{
+					"resource_id":      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+					"metric_namespace": "microsoft.compute/virtualmachines",
+					"metric_name":      "Percentage CPU",
+					"aggregation":      "Maximum",
+					"chart_type":       "Area",
+					"time_range":       "PT12H",
+					"title":            "",
+				},
+			},
+			"log_query": []interface{}{},
+		},
+		map[string]interface{}{
+			"x":             0,
+			"y":             4,
+			"col_span":      12,
+			"row_span":      4,
+			"markdown":      []interface{}{},
+			"metrics_chart": []interface{}{},
+			"log_query": []interface{}{
+				map[string]interface{}{
+					"workspace_id":  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.OperationalInsights/workspaces/workspace1",
+					"query":         "Heartbeat | summarize count() by bin(TimeGenerated, 1h)",
+					"time_range":    "P7D",
+					"title":         "Heartbeats",
+					"subtitle":      "workspace1",
+					"visualization": "line",
+				},
+			},
+		},
+	}
+
+	properties, err := expandPortalDashboardTiles(input)
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+
+	// the properties are round-tripped through JSON since that's how they're returned by the API
+	raw, err := json.Marshal(properties)
+	if err != nil {
+		t.Fatalf("marshaling: %+v", err)
+	}
+	var returned dashboard.DashboardProperties
+	if err := json.Unmarshal(raw, &returned); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+
+	actual := flattenPortalDashboardTiles(&returned)
+	if !reflect.DeepEqual(input, actual) {
+		t.Fatalf("Expected %+v but got %+v", input, actual)
+	}
+}
+
+func TestPortalDashboardTilesExactlyOnePart(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"x":             0,
+			"y":             0,
+			"col_span":      6,
+			"row_span":      4,
+			"markdown":      []interface{}{},
+			"metrics_chart": []interface{}{},
+			"log_query":     []interface{}{},
+		},
+	}
+
+	if _, err := expandPortalDashboardTiles(input); err == nil {
+		t.Fatalf("Expected an error when no part is specified for a tile")
+	}
+}
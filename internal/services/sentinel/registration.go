@@ -82,5 +82,11 @@ func (r Registration) Resources() []sdk.Resource {
 		MetadataResource{},
 		AlertRuleAnomalyDuplicateResource{},
 		ThreatIntelligenceIndicator{},
+		ContentPackageResource{},
+		ContentTemplateInstanceResource{},
+		HuntingQueryResource{},
+		WorkbookResource{},
+		DataConnectorDefinitionResource{},
+		DataConnectorRestApiPollerResource{},
 	}
 }
@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contentproductpackages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contentproducttemplates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contenttemplates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/client"
+)
+
+// findSentinelContentProductPackage returns the Content Hub catalog entry for the specified Content ID, which is always
+// the latest version of the package which is available
+func findSentinelContentProductPackage(ctx context.Context, client *contentproductpackages.ContentProductPackagesClient, workspaceId workspaces.WorkspaceId, contentId string) (*contentproductpackages.ProductPackageModel, error) {
+	id := contentproductpackages.NewWorkspaceID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName)
+	options := contentproductpackages.ProductPackagesListOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("properties/contentId eq '%s'", contentId)),
+	}
+	resp, err := client.ProductPackagesListComplete(ctx, id, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing Content Product Packages for %s: %+v", workspaceId, err)
+	}
+
+	for _, item := range resp.Items {
+		if item.Properties != nil && strings.EqualFold(pointer.From(item.Properties.ContentId), contentId) {
+			return &item, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// installSentinelContentPackageTemplates installs the templates contained within the specified version of a Content
+// Hub package into the workspace, returning the names of the Content Templates which were installed
+func installSentinelContentPackageTemplates(ctx context.Context, sentinelClient *client.Client, workspaceId workspaces.WorkspaceId, contentId, version string) (map[string]struct{}, error) {
+	catalogId := contentproducttemplates.NewWorkspaceID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName)
+	options := contentproducttemplates.ProductTemplatesListOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("properties/packageId eq '%s' and properties/packageVersion eq '%s'", contentId, version)),
+	}
+	resp, err := sentinelClient.ContentProductTemplatesClient.ProductTemplatesListComplete(ctx, catalogId, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing Content Product Templates for %s: %+v", workspaceId, err)
+	}
+
+	installed := make(map[string]struct{})
+	for _, item := range resp.Items {
+		if item.Name == nil {
+			continue
+		}
+
+		// the packaged content of each template is only returned when retrieving the template individually
+		productTemplateId := contentproducttemplates.NewContentProductTemplateID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, *item.Name)
+		productTemplate, err := sentinelClient.ContentProductTemplatesClient.ProductTemplateGet(ctx, productTemplateId)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", productTemplateId, err)
+		}
+		if productTemplate.Model == nil || productTemplate.Model.Properties == nil {
+			return nil, fmt.Errorf("retrieving %s: `properties` was nil", productTemplateId)
+		}
+
+		// the catalog and workspace templates share the same schema, other than the template being specified as the
+		// `packagedContent` in the catalog and as the `mainTemplate` once installed
+		properties, err := json.Marshal(productTemplate.Model.Properties)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling properties for %s: %+v", productTemplateId, err)
+		}
+		input := contenttemplates.TemplateModel{}
+		if err := json.Unmarshal(properties, &input.Properties); err != nil {
+			return nil, fmt.Errorf("unmarshaling properties for %s: %+v", productTemplateId, err)
+		}
+		input.Properties.MainTemplate = productTemplate.Model.Properties.PackagedContent
+
+		templateId := contenttemplates.NewContentTemplateID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, *item.Name)
+		if _, err := sentinelClient.ContentTemplatesClient.ContentTemplateInstall(ctx, templateId, input); err != nil {
+			return nil, fmt.Errorf("installing %s: %+v", templateId, err)
+		}
+		installed[*item.Name] = struct{}{}
+	}
+
+	return installed, nil
+}
+
+// listSentinelContentPackageTemplates returns the Content Templates installed into the workspace from the specified package
+func listSentinelContentPackageTemplates(ctx context.Context, client *contenttemplates.ContentTemplatesClient, workspaceId workspaces.WorkspaceId, contentId string) ([]contenttemplates.TemplateModel, error) {
+	id := contenttemplates.NewWorkspaceID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName)
+	options := contenttemplates.ListOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("properties/packageId eq '%s'", contentId)),
+	}
+	resp, err := client.ListComplete(ctx, id, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing Content Templates for %s: %+v", workspaceId, err)
+	}
+
+	return resp.Items, nil
+}
+
+// removeSentinelContentPackageTemplates removes the Content Templates installed from the specified package, other than
+// those which should be retained
+func removeSentinelContentPackageTemplates(ctx context.Context, client *contenttemplates.ContentTemplatesClient, workspaceId workspaces.WorkspaceId, contentId string, retain map[string]struct{}) error {
+	templates, err := listSentinelContentPackageTemplates(ctx, client, workspaceId, contentId)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range templates {
+		if item.Name == nil {
+			continue
+		}
+		if _, ok := retain[*item.Name]; ok {
+			continue
+		}
+
+		templateId := contenttemplates.NewContentTemplateID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, *item.Name)
+		if _, err := client.ContentTemplateDelete(ctx, templateId); err != nil {
+			return fmt.Errorf("deleting %s: %+v", templateId, err)
+		}
+	}
+
+	return nil
+}
+
+// findSentinelContentTemplate returns the installed Content Template with the specified Content ID, optionally
+// restricted to a specific version
+func findSentinelContentTemplate(ctx context.Context, client *contenttemplates.ContentTemplatesClient, workspaceId workspaces.WorkspaceId, contentId, version string) (*contenttemplates.TemplateModel, error) {
+	id := contenttemplates.NewWorkspaceID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName)
+	options := contenttemplates.ListOperationOptions{
+		Expand: pointer.To("properties/mainTemplate"),
+		Filter: pointer.To(fmt.Sprintf("properties/contentId eq '%s'", contentId)),
+	}
+	resp, err := client.ListComplete(ctx, id, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing Content Templates for %s: %+v", workspaceId, err)
+	}
+
+	versions := make([]string, 0)
+	for _, item := range resp.Items {
+		if item.Properties == nil || !strings.EqualFold(pointer.From(item.Properties.ContentId), contentId) {
+			continue
+		}
+
+		itemVersion := pointer.From(item.Properties.Version)
+		if version == "" || itemVersion == version {
+			return &item, nil
+		}
+		versions = append(versions, itemVersion)
+	}
+
+	if len(versions) > 0 {
+		return nil, fmt.Errorf("version %q of the Content Template %q is not installed in %s, the installed versions are: %s", version, contentId, workspaceId, strings.Join(versions, ", "))
+	}
+
+	return nil, fmt.Errorf("the Content Template %q is not installed in %s - ensure the package containing it has been installed", contentId, workspaceId)
+}
+
+var sentinelContentTemplateExpressionRegex = regexp.MustCompile(`^\[(parameters|variables)\('([^']+)'\)\]$`)
+
+// resolveSentinelContentTemplateExpressions resolves the ARM Template expressions referencing a parameter or variable
+// within the specified value, since the resources within a Content Template are defined as an ARM Template. Other
+// expressions aren't supported, and should be overridden.
+func resolveSentinelContentTemplateExpressions(input interface{}, parameters, variables map[string]interface{}) (interface{}, error) {
+	return resolveSentinelContentTemplateValue(input, parameters, variables, make(map[string]struct{}))
+}
+
+// resolveSentinelContentTemplateValue resolves the expressions within the value, where `resolving` tracks the
+// parameters and variables currently being resolved so that one which (indirectly) references itself raises an
+// error rather than recursing indefinitely
+func resolveSentinelContentTemplateValue(input interface{}, parameters, variables map[string]interface{}, resolving map[string]struct{}) (interface{}, error) {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		output := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			resolved, err := resolveSentinelContentTemplateValue(value, parameters, variables, resolving)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %+v", key, err)
+			}
+			output[key] = resolved
+		}
+		return output, nil
+
+	case []interface{}:
+		output := make([]interface{}, 0, len(v))
+		for i, value := range v {
+			resolved, err := resolveSentinelContentTemplateValue(value, parameters, variables, resolving)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %+v", i, err)
+			}
+			output = append(output, resolved)
+		}
+		return output, nil
+
+	case string:
+		// a leading `[[` is used to escape a literal value starting with `[`
+		if strings.HasPrefix(v, "[[") {
+			return v[1:], nil
+		}
+		if !strings.HasPrefix(v, "[") || !strings.HasSuffix(v, "]") {
+			return v, nil
+		}
+
+		matches := sentinelContentTemplateExpressionRegex.FindStringSubmatch(v)
+		if matches == nil {
+			return nil, fmt.Errorf("the template expression %q is not supported", v)
+		}
+
+		kind := strings.TrimSuffix(matches[1], "s")
+		values := parameters
+		if matches[1] == "variables" {
+			values = variables
+		}
+		value, ok := values[matches[2]]
+		if !ok {
+			return nil, fmt.Errorf("no value was specified for the %s %q", kind, matches[2])
+		}
+
+		key := fmt.Sprintf("%s/%s", matches[1], matches[2])
+		if _, ok := resolving[key]; ok {
+			return nil, fmt.Errorf("the %s %q references itself", kind, matches[2])
+		}
+		resolving[key] = struct{}{}
+		defer delete(resolving, key)
+
+		return resolveSentinelContentTemplateValue(value, parameters, variables, resolving)
+	}
+
+	return input, nil
+}
+
+// expandSentinelContentTemplateProperties resolves the expressions within the properties of a resource in a Content
+// Template, replacing any properties specified in `propertiesOverride` (a JSON object). The overridden properties are
+// removed before the expressions are resolved, so that any unsupported expressions within them don't raise an error.
+func expandSentinelContentTemplateProperties(input interface{}, parameters, variables map[string]interface{}, propertiesOverride string) (map[string]interface{}, error) {
+	properties, ok := input.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("the properties were not an object")
+	}
+
+	overrides := make(map[string]interface{})
+	if propertiesOverride != "" {
+		if err := json.Unmarshal([]byte(propertiesOverride), &overrides); err != nil {
+			return nil, fmt.Errorf("parsing `properties_override`: %+v", err)
+		}
+	}
+
+	remaining := make(map[string]interface{}, len(properties))
+	for key, value := range properties {
+		if _, ok := overrides[key]; !ok {
+			remaining[key] = value
+		}
+	}
+
+	resolved, err := resolveSentinelContentTemplateExpressions(remaining, parameters, variables)
+	if err != nil {
+		return nil, fmt.Errorf("%+v - unsupported expressions can be replaced using `properties_override`", err)
+	}
+
+	output := resolved.(map[string]interface{})
+	for key, value := range overrides {
+		output[key] = value
+	}
+
+	return output, nil
+}
+
+// expandSentinelContentTemplateParameters returns the values of the parameters of an ARM Template, using the specified
+// values in preference to the default value of each parameter
+func expandSentinelContentTemplateParameters(template map[string]interface{}, input map[string]string) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+
+	definitions, _ := template["parameters"].(map[string]interface{})
+	for name, raw := range definitions {
+		definition, _ := raw.(map[string]interface{})
+		if value, ok := definition["defaultValue"]; ok {
+			output[name] = value
+		}
+	}
+
+	for name, value := range input {
+		definition, _ := definitions[name].(map[string]interface{})
+		parameterType, _ := definition["type"].(string)
+
+		switch strings.ToLower(parameterType) {
+		case "int":
+			v, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the value of the parameter %q as an integer: %+v", name, err)
+			}
+			output[name] = v
+		case "bool":
+			v, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the value of the parameter %q as a boolean: %+v", name, err)
+			}
+			output[name] = v
+		case "array", "object":
+			var v interface{}
+			if err := json.Unmarshal([]byte(value), &v); err != nil {
+				return nil, fmt.Errorf("parsing the value of the parameter %q as JSON: %+v", name, err)
+			}
+			output[name] = v
+		default:
+			output[name] = value
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contentpackages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContentPackageModel struct {
+	WorkspaceId   string                        `tfschema:"workspace_id"`
+	ContentId     string                        `tfschema:"content_id"`
+	Version       string                        `tfschema:"version"`
+	DisplayName   string                        `tfschema:"display_name"`
+	Kind          string                        `tfschema:"kind"`
+	LatestVersion string                        `tfschema:"latest_version"`
+	Template      []ContentPackageTemplateModel `tfschema:"template"`
+}
+
+type ContentPackageTemplateModel struct {
+	ContentId   string `tfschema:"content_id"`
+	DisplayName string `tfschema:"display_name"`
+	Kind        string `tfschema:"kind"`
+	Version     string `tfschema:"version"`
+}
+
+type ContentPackageResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContentPackageResource{}
+
+func (r ContentPackageResource) ResourceType() string {
+	return "azurerm_sentinel_content_package"
+}
+
+func (r ContentPackageResource) ModelObject() interface{} {
+	return &ContentPackageModel{}
+}
+
+func (r ContentPackageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return contentpackages.ValidateContentPackageID
+}
+
+func (r ContentPackageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"content_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ContentPackageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"latest_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"template": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"content_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"kind": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ContentPackageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			var config ContentPackageModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(config.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := contentpackages.NewContentPackageID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, config.ContentId)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the ID is set once the package itself has been installed, see installSentinelContentPackage
+			if err := installSentinelContentPackage(ctx, metadata, *workspaceId, id, config.Version); err != nil {
+				return err
+			}
+
+			return r.Read().Func(ctx, metadata)
+		},
+	}
+}
+
+func (r ContentPackageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			id, err := contentpackages.ParseContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			workspaceId := workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
+			state := ContentPackageModel{
+				WorkspaceId: workspaceId.ID(),
+				ContentId:   id.PackageId,
+				Template:    make([]ContentPackageTemplateModel, 0),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ContentId = pointer.From(props.ContentId)
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.Kind = string(pointer.From(props.ContentKind))
+					state.Version = pointer.From(props.Version)
+				}
+			}
+
+			catalog, err := findSentinelContentProductPackage(ctx, metadata.Client.Sentinel.ContentProductPackagesClient, workspaceId, state.ContentId)
+			if err != nil {
+				return err
+			}
+			if catalog != nil && catalog.Properties != nil {
+				state.LatestVersion = pointer.From(catalog.Properties.Version)
+			}
+
+			templates, err := listSentinelContentPackageTemplates(ctx, metadata.Client.Sentinel.ContentTemplatesClient, workspaceId, state.ContentId)
+			if err != nil {
+				return err
+			}
+			for _, item := range templates {
+				if props := item.Properties; props != nil {
+					state.Template = append(state.Template, ContentPackageTemplateModel{
+						ContentId:   pointer.From(props.ContentId),
+						DisplayName: pointer.From(props.DisplayName),
+						Kind:        string(pointer.From(props.ContentKind)),
+						Version:     pointer.From(props.Version),
+					})
+				}
+			}
+			sort.Slice(state.Template, func(i, j int) bool {
+				return state.Template[i].ContentId < state.Template[j].ContentId
+			})
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContentPackageResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := contentpackages.ParseContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContentPackageModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("version") {
+				workspaceId := workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
+				if err := installSentinelContentPackage(ctx, metadata, workspaceId, *id, config.Version); err != nil {
+					return err
+				}
+			}
+
+			return r.Read().Func(ctx, metadata)
+		},
+	}
+}
+
+func (r ContentPackageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			id, err := contentpackages.ParseContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			workspaceId := workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
+			if err := removeSentinelContentPackageTemplates(ctx, metadata.Client.Sentinel.ContentTemplatesClient, workspaceId, id.PackageId, nil); err != nil {
+				return fmt.Errorf("removing templates for %s: %+v", *id, err)
+			}
+
+			if _, err := client.ContentPackageUninstall(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// installSentinelContentPackage installs (or upgrades) the package from the Content Hub catalog, along with the
+// templates it contains. Only the latest version of each package is available in the catalog, so an error is
+// returned when a different version is requested. The ID is set as soon as the package has been installed, so that
+// it's tracked in the state should installing the templates fail.
+func installSentinelContentPackage(ctx context.Context, metadata sdk.ResourceMetaData, workspaceId workspaces.WorkspaceId, id contentpackages.ContentPackageId, version string) error {
+	catalog, err := findSentinelContentProductPackage(ctx, metadata.Client.Sentinel.ContentProductPackagesClient, workspaceId, id.PackageId)
+	if err != nil {
+		return err
+	}
+	if catalog == nil || catalog.Properties == nil {
+		return fmt.Errorf("the package %q was not found in the Content Hub for %s", id.PackageId, workspaceId)
+	}
+
+	latestVersion := pointer.From(catalog.Properties.Version)
+	if version != "" && version != latestVersion {
+		return fmt.Errorf("version %q of the package %q is not available in the Content Hub, the latest available version is %q", version, id.PackageId, latestVersion)
+	}
+
+	// the catalog and installed packages share the same schema
+	properties, err := json.Marshal(catalog.Properties)
+	if err != nil {
+		return fmt.Errorf("marshaling properties for the package %q: %+v", id.PackageId, err)
+	}
+	input := contentpackages.PackageModel{}
+	if err := json.Unmarshal(properties, &input.Properties); err != nil {
+		return fmt.Errorf("unmarshaling properties for the package %q: %+v", id.PackageId, err)
+	}
+
+	if _, err := metadata.Client.Sentinel.ContentPackagesClient.ContentPackageInstall(ctx, id, input); err != nil {
+		return fmt.Errorf("installing %s: %+v", id, err)
+	}
+	metadata.SetID(id)
+
+	installed, err := installSentinelContentPackageTemplates(ctx, metadata.Client.Sentinel, workspaceId, id.PackageId, latestVersion)
+	if err != nil {
+		return fmt.Errorf("installing templates for %s: %+v", id, err)
+	}
+
+	// templates which are no longer part of the package are removed when it's upgraded
+	if err := removeSentinelContentPackageTemplates(ctx, metadata.Client.Sentinel.ContentTemplatesClient, workspaceId, id.PackageId, installed); err != nil {
+		return fmt.Errorf("removing outdated templates for %s: %+v", id, err)
+	}
+
+	return nil
+}
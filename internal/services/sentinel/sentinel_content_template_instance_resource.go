@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contenttemplates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContentTemplateInstanceModel struct {
+	Name               string            `tfschema:"name"`
+	WorkspaceId        string            `tfschema:"workspace_id"`
+	TemplateContentId  string            `tfschema:"template_content_id"`
+	TemplateVersion    string            `tfschema:"template_version"`
+	Parameters         map[string]string `tfschema:"parameters"`
+	PropertiesOverride string            `tfschema:"properties_override"`
+	DisplayName        string            `tfschema:"display_name"`
+	Kind               string            `tfschema:"kind"`
+}
+
+type ContentTemplateInstanceResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContentTemplateInstanceResource{}
+
+func (r ContentTemplateInstanceResource) ResourceType() string {
+	return "azurerm_sentinel_content_template_instance"
+}
+
+func (r ContentTemplateInstanceResource) ModelObject() interface{} {
+	return &ContentTemplateInstanceModel{}
+}
+
+func (r ContentTemplateInstanceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return alertrules.ValidateAlertRuleID
+}
+
+func (r ContentTemplateInstanceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"template_content_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"template_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"parameters": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"properties_override": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+	}
+}
+
+func (r ContentTemplateInstanceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContentTemplateInstanceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.AlertRulesClient
+
+			var config ContentTemplateInstanceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(config.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := alertrules.NewAlertRuleID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := deploySentinelContentTemplateInstance(ctx, metadata, *workspaceId, id, config); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return r.Read().Func(ctx, metadata)
+		},
+	}
+}
+
+func (r ContentTemplateInstanceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.AlertRulesClient
+
+			id, err := alertrules.ParseAlertRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var state ContentTemplateInstanceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.Name = id.RuleId
+			state.WorkspaceId = workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID()
+
+			if resp.Model != nil {
+				// the Alert Rule is a discriminated type, so the common properties are read from the JSON representation
+				raw, err := json.Marshal(resp.Model)
+				if err != nil {
+					return fmt.Errorf("marshaling %s: %+v", *id, err)
+				}
+				var rule map[string]interface{}
+				if err := json.Unmarshal(raw, &rule); err != nil {
+					return fmt.Errorf("unmarshaling %s: %+v", *id, err)
+				}
+
+				state.Kind = string(resp.Model.AlertRule().Kind)
+				if properties, ok := rule["properties"].(map[string]interface{}); ok {
+					state.DisplayName, _ = properties["displayName"].(string)
+					if v, ok := properties["alertRuleTemplateName"].(string); ok && v != "" {
+						state.TemplateContentId = v
+					}
+					if v, ok := properties["templateVersion"].(string); ok && v != "" {
+						state.TemplateVersion = v
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContentTemplateInstanceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := alertrules.ParseAlertRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContentTemplateInstanceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Alert Rule is re-deployed from the template, since the template may have been upgraded in the meantime
+			workspaceId := workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
+			if err := deploySentinelContentTemplateInstance(ctx, metadata, workspaceId, *id, config); err != nil {
+				return err
+			}
+
+			return r.Read().Func(ctx, metadata)
+		},
+	}
+}
+
+func (r ContentTemplateInstanceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.AlertRulesClient
+
+			id, err := alertrules.ParseAlertRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// deploySentinelContentTemplateInstance creates (or updates) the Alert Rule defined within the ARM Template of an
+// installed Content Template, applying the specified parameters and property overrides
+func deploySentinelContentTemplateInstance(ctx context.Context, metadata sdk.ResourceMetaData, workspaceId workspaces.WorkspaceId, id alertrules.AlertRuleId, config ContentTemplateInstanceModel) error {
+	template, err := findSentinelContentTemplate(ctx, metadata.Client.Sentinel.ContentTemplatesClient, workspaceId, config.TemplateContentId, config.TemplateVersion)
+	if err != nil {
+		return err
+	}
+	if template.Properties == nil || template.Properties.MainTemplate == nil {
+		return fmt.Errorf("the Content Template %q did not contain a `mainTemplate`", config.TemplateContentId)
+	}
+	if kind := pointer.From(template.Properties.ContentKind); kind != contenttemplates.KindAnalyticsRule {
+		return fmt.Errorf("the Content Template %q is of kind %q, only templates of kind %q are supported", config.TemplateContentId, kind, contenttemplates.KindAnalyticsRule)
+	}
+
+	mainTemplate, ok := (*template.Properties.MainTemplate).(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("the `mainTemplate` of the Content Template %q was not an object", config.TemplateContentId)
+	}
+
+	var resource map[string]interface{}
+	resources, _ := mainTemplate["resources"].([]interface{})
+	for _, raw := range resources {
+		if v, ok := raw.(map[string]interface{}); ok {
+			if resourceType, _ := v["type"].(string); strings.HasSuffix(strings.ToLower(resourceType), "/alertrules") {
+				resource = v
+				break
+			}
+		}
+	}
+	if resource == nil {
+		return fmt.Errorf("the Content Template %q did not contain an Alert Rule", config.TemplateContentId)
+	}
+
+	parameters, err := expandSentinelContentTemplateParameters(mainTemplate, config.Parameters)
+	if err != nil {
+		return err
+	}
+	variables, _ := mainTemplate["variables"].(map[string]interface{})
+
+	properties, err := expandSentinelContentTemplateProperties(resource["properties"], parameters, variables, config.PropertiesOverride)
+	if err != nil {
+		return fmt.Errorf("resolving the properties of the Alert Rule in the Content Template %q: %+v", config.TemplateContentId, err)
+	}
+
+	// linking the Alert Rule to its template allows the Portal to report when a newer version of the template is available
+	properties["alertRuleTemplateName"] = pointer.From(template.Properties.ContentId)
+	properties["templateVersion"] = pointer.From(template.Properties.Version)
+
+	raw, err := json.Marshal(map[string]interface{}{
+		"kind":       resource["kind"],
+		"properties": properties,
+	})
+	if err != nil {
+		return fmt.Errorf("marshaling the Alert Rule: %+v", err)
+	}
+	input, err := alertrules.UnmarshalAlertRuleImplementation(raw)
+	if err != nil {
+		return fmt.Errorf("unmarshaling the Alert Rule: %+v", err)
+	}
+	if _, ok := input.(alertrules.RawAlertRuleImpl); ok {
+		return fmt.Errorf("the Alert Rule in the Content Template %q is of kind %v, which is not supported", config.TemplateContentId, resource["kind"])
+	}
+
+	if _, err := metadata.Client.Sentinel.AlertRulesClient.CreateOrUpdate(ctx, id, input); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SentinelContentTemplateInstanceResource struct{}
+
+func TestAccSentinelContentTemplateInstance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_content_template_instance", "test")
+	r := SentinelContentTemplateInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("template_version").Exists(),
+				check.That(data.ResourceName).Key("display_name").Exists(),
+			),
+		},
+		data.ImportStep("parameters", "properties_override"),
+	})
+}
+
+func TestAccSentinelContentTemplateInstance_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_content_template_instance", "test")
+	r := SentinelContentTemplateInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSentinelContentTemplateInstance_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_content_template_instance", "test")
+	r := SentinelContentTemplateInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("parameters", "properties_override"),
+		{
+			Config: r.overridden(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue("Overridden Rule"),
+			),
+		},
+		data.ImportStep("parameters", "properties_override"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("parameters", "properties_override"),
+	})
+}
+
+func (r SentinelContentTemplateInstanceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := alertrules.ParseAlertRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Sentinel.AlertRulesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r SentinelContentTemplateInstanceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_content_template_instance" "test" {
+  name                = "acctest-%d"
+  workspace_id        = azurerm_sentinel_content_package.test.workspace_id
+  template_content_id = local.template_content_id
+
+  properties_override = jsonencode({
+    enabled = false
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SentinelContentTemplateInstanceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_content_template_instance" "import" {
+  name                = azurerm_sentinel_content_template_instance.test.name
+  workspace_id        = azurerm_sentinel_content_template_instance.test.workspace_id
+  template_content_id = azurerm_sentinel_content_template_instance.test.template_content_id
+}
+`, r.basic(data))
+}
+
+func (r SentinelContentTemplateInstanceResource) overridden(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_content_template_instance" "test" {
+  name                = "acctest-%d"
+  workspace_id        = azurerm_sentinel_content_package.test.workspace_id
+  template_content_id = local.template_content_id
+  template_version    = local.template_version
+
+  properties_override = jsonencode({
+    enabled     = false
+    displayName = "Overridden Rule"
+    severity    = "High"
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (SentinelContentTemplateInstanceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_content_package" "test" {
+  workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  content_id   = "azuresentinel.azure-sentinel-solution-azureactivity"
+}
+
+locals {
+  analytics_rules     = [for t in azurerm_sentinel_content_package.test.template : t if t.kind == "AnalyticsRule"]
+  template_content_id = local.analytics_rules[0].content_id
+  template_version    = local.analytics_rules[0].version
+}
+`, SentinelContentPackageResource{}.template(data))
+}
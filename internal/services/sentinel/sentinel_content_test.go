@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolveSentinelContentTemplateExpressions(t *testing.T) {
+	cases := []struct {
+		Input      interface{}
+		Parameters map[string]interface{}
+		Variables  map[string]interface{}
+		Expected   interface{}
+		Error      bool
+	}{
+		{
+			Input:    "[[literal]",
+			Expected: "[literal]",
+		},
+		{
+			Input: map[string]interface{}{
+				"displayName": "[parameters('name')]",
+				"queries":     []interface{}{"[variables('query')]"},
+			},
+			Parameters: map[string]interface{}{
+				"name": "example",
+			},
+			Variables: map[string]interface{}{
+				"query": "[parameters('name')]",
+			},
+			Expected: map[string]interface{}{
+				"displayName": "example",
+				"queries":     []interface{}{"example"},
+			},
+		},
+		{
+			// the same variable can be referenced more than once, so long as it doesn't reference itself
+			Input: []interface{}{"[variables('first')]", "[variables('first')]"},
+			Variables: map[string]interface{}{
+				"first":  "[variables('second')]",
+				"second": "value",
+			},
+			Expected: []interface{}{"value", "value"},
+		},
+		{
+			Input: "[variables('self')]",
+			Variables: map[string]interface{}{
+				"self": "[variables('self')]",
+			},
+			Error: true,
+		},
+		{
+			Input: "[variables('first')]",
+			Parameters: map[string]interface{}{
+				"second": []interface{}{"[variables('first')]"},
+			},
+			Variables: map[string]interface{}{
+				"first": "[parameters('second')]",
+			},
+			Error: true,
+		},
+		{
+			Input: "[concat(parameters('name'), '-suffix')]",
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := resolveSentinelContentTemplateExpressions(tc.Input, tc.Parameters, tc.Variables)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("expected an error resolving %+v but didn't get one", tc.Input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("resolving %+v: %+v", tc.Input, err)
+		}
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestExpandSentinelContentTemplateProperties(t *testing.T) {
+	properties := map[string]interface{}{
+		"displayName": "[parameters('name')]",
+		"query":       "[concat(variables('table'), ' | take 10')]",
+		"severity":    "High",
+	}
+	parameters := map[string]interface{}{
+		"name": "example",
+	}
+
+	cases := []struct {
+		Override string
+		Expected map[string]interface{}
+		Error    bool
+	}{
+		{
+			// the unsupported expression is only an error when it isn't overridden
+			Override: "",
+			Error:    true,
+		},
+		{
+			Override: `{"query": "SecurityEvent | take 10", "severity": "Low"}`,
+			Expected: map[string]interface{}{
+				"displayName": "example",
+				"query":       "SecurityEvent | take 10",
+				"severity":    "Low",
+			},
+		},
+		{
+			// overridden values are used as-is rather than being resolved
+			Override: `{"displayName": "[parameters('other')]", "query": "SecurityEvent"}`,
+			Expected: map[string]interface{}{
+				"displayName": "[parameters('other')]",
+				"query":       "SecurityEvent",
+				"severity":    "High",
+			},
+		},
+		{
+			Override: `not json`,
+			Error:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := expandSentinelContentTemplateProperties(properties, parameters, nil, tc.Override)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("expected an error expanding with the override %q but didn't get one", tc.Override)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expanding with the override %q: %+v", tc.Override, err)
+		}
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
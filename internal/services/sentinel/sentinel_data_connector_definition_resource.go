@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/connectordefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DataConnectorDefinitionModel struct {
+	Name                    string                                          `tfschema:"name"`
+	LogAnalyticsWorkspaceId string                                          `tfschema:"log_analytics_workspace_id"`
+	ConnectorUiConfigJson   string                                          `tfschema:"connector_ui_config_json"`
+	ConnectionsConfig       []DataConnectorDefinitionConnectionsConfigModel `tfschema:"connections_config"`
+}
+
+type DataConnectorDefinitionConnectionsConfigModel struct {
+	TemplateSpecName    string `tfschema:"template_spec_name"`
+	TemplateSpecVersion string `tfschema:"template_spec_version"`
+}
+
+type DataConnectorDefinitionResource struct{}
+
+var _ sdk.ResourceWithUpdate = DataConnectorDefinitionResource{}
+
+func (r DataConnectorDefinitionResource) ResourceType() string {
+	return "azurerm_sentinel_data_connector_definition"
+}
+
+func (r DataConnectorDefinitionResource) ModelObject() interface{} {
+	return &DataConnectorDefinitionModel{}
+}
+
+func (r DataConnectorDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return connectordefinitions.ValidateDataConnectorDefinitionID
+}
+
+func (r DataConnectorDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"connector_ui_config_json": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"connections_config": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"template_spec_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"template_spec_version": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r DataConnectorDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DataConnectorDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ConnectorDefinitionsClient
+
+			var plan DataConnectorDefinitionModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(plan.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := connectordefinitions.NewDataConnectorDefinitionID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, plan.Name)
+
+			existing, err := client.DataConnectorDefinitionsGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			uiConfig, err := expandDataConnectorDefinitionUiConfig(plan.ConnectorUiConfigJson)
+			if err != nil {
+				return err
+			}
+
+			input := connectordefinitions.CustomizableConnectorDefinition{
+				Properties: &connectordefinitions.CustomizableConnectorDefinitionProperties{
+					ConnectionsConfig: expandDataConnectorDefinitionConnectionsConfig(plan.ConnectionsConfig),
+					ConnectorUiConfig: *uiConfig,
+				},
+			}
+
+			if _, err := client.DataConnectorDefinitionsCreateOrUpdate(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DataConnectorDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ConnectorDefinitionsClient
+
+			id, err := connectordefinitions.ParseDataConnectorDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.DataConnectorDefinitionsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config DataConnectorDefinitionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := DataConnectorDefinitionModel{
+				Name:                    id.DataConnectorDefinitionName,
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if resp.Model != nil {
+				model, ok := resp.Model.(connectordefinitions.CustomizableConnectorDefinition)
+				if !ok {
+					return fmt.Errorf("%s was not a Customizable Data Connector Definition", *id)
+				}
+
+				if props := model.Properties; props != nil {
+					state.ConnectionsConfig = flattenDataConnectorDefinitionConnectionsConfig(props.ConnectionsConfig)
+
+					uiConfig, err := flattenDataConnectorDefinitionUiConfig(props.ConnectorUiConfig, config.ConnectorUiConfigJson)
+					if err != nil {
+						return fmt.Errorf("flattening `connector_ui_config_json`: %+v", err)
+					}
+					state.ConnectorUiConfigJson = uiConfig
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DataConnectorDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ConnectorDefinitionsClient
+
+			id, err := connectordefinitions.ParseDataConnectorDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var plan DataConnectorDefinitionModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.DataConnectorDefinitionsGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			input, ok := existing.Model.(connectordefinitions.CustomizableConnectorDefinition)
+			if !ok || input.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil or not a Customizable Data Connector Definition", *id)
+			}
+
+			if metadata.ResourceData.HasChange("connector_ui_config_json") {
+				uiConfig, err := expandDataConnectorDefinitionUiConfig(plan.ConnectorUiConfigJson)
+				if err != nil {
+					return err
+				}
+				input.Properties.ConnectorUiConfig = *uiConfig
+			}
+
+			if metadata.ResourceData.HasChange("connections_config") {
+				input.Properties.ConnectionsConfig = expandDataConnectorDefinitionConnectionsConfig(plan.ConnectionsConfig)
+			}
+
+			if _, err := client.DataConnectorDefinitionsCreateOrUpdate(ctx, *id, input); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DataConnectorDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ConnectorDefinitionsClient
+
+			id, err := connectordefinitions.ParseDataConnectorDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.DataConnectorDefinitionsDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// expandDataConnectorDefinitionUiConfig parses the UI configuration, rejecting unknown fields since these would
+// otherwise be silently dropped by the API
+func expandDataConnectorDefinitionUiConfig(input string) (*connectordefinitions.CustomizableConnectorUiConfig, error) {
+	decoder := json.NewDecoder(bytes.NewBufferString(input))
+	decoder.DisallowUnknownFields()
+
+	var output connectordefinitions.CustomizableConnectorUiConfig
+	if err := decoder.Decode(&output); err != nil {
+		return nil, fmt.Errorf("parsing `connector_ui_config_json`: %+v", err)
+	}
+
+	return &output, nil
+}
+
+// flattenDataConnectorDefinitionUiConfig returns the existing value when it's equivalent to the UI configuration
+// returned from the API, since the API may re-order or omit fields which aren't set
+func flattenDataConnectorDefinitionUiConfig(input connectordefinitions.CustomizableConnectorUiConfig, existing string) (string, error) {
+	output, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	if existing != "" {
+		if config, err := expandDataConnectorDefinitionUiConfig(existing); err == nil {
+			normalized, err := json.Marshal(config)
+			if err == nil && bytes.Equal(normalized, output) {
+				return existing, nil
+			}
+		}
+	}
+
+	return string(output), nil
+}
+
+func expandDataConnectorDefinitionConnectionsConfig(input []DataConnectorDefinitionConnectionsConfigModel) *connectordefinitions.CustomizableConnectionsConfig {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &connectordefinitions.CustomizableConnectionsConfig{
+		TemplateSpecName:    input[0].TemplateSpecName,
+		TemplateSpecVersion: input[0].TemplateSpecVersion,
+	}
+}
+
+func flattenDataConnectorDefinitionConnectionsConfig(input *connectordefinitions.CustomizableConnectionsConfig) []DataConnectorDefinitionConnectionsConfigModel {
+	if input == nil {
+		return []DataConnectorDefinitionConnectionsConfigModel{}
+	}
+
+	return []DataConnectorDefinitionConnectionsConfigModel{
+		{
+			TemplateSpecName:    input.TemplateSpecName,
+			TemplateSpecVersion: input.TemplateSpecVersion,
+		},
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/connectordefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SentinelDataConnectorDefinitionResource struct{}
+
+func TestAccSentinelDataConnectorDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_definition", "test")
+	r := SentinelDataConnectorDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Acceptance Test Connector"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelDataConnectorDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_definition", "test")
+	r := SentinelDataConnectorDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Acceptance Test Connector"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "Updated Acceptance Test Connector"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelDataConnectorDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_definition", "test")
+	r := SentinelDataConnectorDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Acceptance Test Connector"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSentinelDataConnectorDefinition_unknownField(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_definition", "test")
+	r := SentinelDataConnectorDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.unknownField(data),
+			ExpectError: regexp.MustCompile("unknown field"),
+		},
+	})
+}
+
+func (r SentinelDataConnectorDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := connectordefinitions.ParseDataConnectorDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Sentinel.ConnectorDefinitionsClient.DataConnectorDefinitionsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r SentinelDataConnectorDefinitionResource) basic(data acceptance.TestData, title string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_data_connector_definition" "test" {
+  name                       = "acctest-dcd-%d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  connector_ui_config_json = jsonencode({
+    title               = %q
+    publisher           = "Acceptance Tests"
+    descriptionMarkdown = "A connector created by the acceptance tests."
+    graphQueries = [
+      {
+        metricName = "Total events received"
+        legend     = "AcceptanceTest_CL"
+        baseQuery  = "AcceptanceTest_CL"
+      }
+    ]
+    dataTypes = [
+      {
+        name                  = "AcceptanceTest_CL"
+        lastDataReceivedQuery = "AcceptanceTest_CL | summarize Time = max(TimeGenerated) | where isnotempty(Time)"
+      }
+    ]
+    connectivityCriteria = [
+      {
+        type = "HasDataConnectors"
+      }
+    ]
+    permissions = {
+      resourceProvider = [
+        {
+          provider               = "Microsoft.OperationalInsights/workspaces"
+          permissionsDisplayText = "Read and Write permissions are required."
+          providerDisplayName    = "Workspace"
+          scope                  = "Workspace"
+          requiredPermissions = {
+            write  = true
+            read   = true
+            delete = true
+          }
+        }
+      ]
+    }
+    instructionSteps = [
+      {
+        title       = "Connect"
+        description = "Provide the API key to start collecting events."
+      }
+    ]
+  })
+}
+`, r.template(data), data.RandomInteger, title)
+}
+
+func (r SentinelDataConnectorDefinitionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_data_connector_definition" "import" {
+  name                       = azurerm_sentinel_data_connector_definition.test.name
+  log_analytics_workspace_id = azurerm_sentinel_data_connector_definition.test.log_analytics_workspace_id
+  connector_ui_config_json   = azurerm_sentinel_data_connector_definition.test.connector_ui_config_json
+}
+`, r.basic(data, "Acceptance Test Connector"))
+}
+
+func (r SentinelDataConnectorDefinitionResource) unknownField(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_data_connector_definition" "test" {
+  name                       = "acctest-dcd-%d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  connector_ui_config_json = jsonencode({
+    title                = "Acceptance Test Connector"
+    publisher            = "Acceptance Tests"
+    descriptionMarkdown  = "A connector created by the acceptance tests."
+    graphQueries         = []
+    dataTypes            = []
+    connectivityCriteria = []
+    permissions          = {}
+    instructionSteps     = []
+    notAField            = true
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (SentinelDataConnectorDefinitionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sentinel-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_sentinel_log_analytics_workspace_onboarding" "test" {
+  workspace_id = azurerm_log_analytics_workspace.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
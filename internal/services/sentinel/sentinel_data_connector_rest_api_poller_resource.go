@@ -0,0 +1,723 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/dataconnectors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DataConnectorRestApiPollerModel struct {
+	Name                    string                                    `tfschema:"name"`
+	LogAnalyticsWorkspaceId string                                    `tfschema:"log_analytics_workspace_id"`
+	ConnectorDefinitionName string                                    `tfschema:"connector_definition_name"`
+	AuthJson                string                                    `tfschema:"auth_json"`
+	Request                 []DataConnectorRestApiPollerRequestModel  `tfschema:"request"`
+	Dcr                     []DataConnectorRestApiPollerDcrModel      `tfschema:"dcr"`
+	DataType                string                                    `tfschema:"data_type"`
+	Paging                  []DataConnectorRestApiPollerPagingModel   `tfschema:"paging"`
+	Response                []DataConnectorRestApiPollerResponseModel `tfschema:"response"`
+	AddOnAttributes         map[string]string                         `tfschema:"add_on_attributes"`
+	Active                  bool                                      `tfschema:"active"`
+}
+
+type DataConnectorRestApiPollerRequestModel struct {
+	ApiEndpoint             string            `tfschema:"api_endpoint"`
+	HttpMethod              string            `tfschema:"http_method"`
+	Headers                 map[string]string `tfschema:"headers"`
+	QueryParameters         map[string]string `tfschema:"query_parameters"`
+	QueryParametersTemplate string            `tfschema:"query_parameters_template"`
+	QueryTimeFormat         string            `tfschema:"query_time_format"`
+	QueryWindowInMinutes    int64             `tfschema:"query_window_in_minutes"`
+	RateLimitQps            int64             `tfschema:"rate_limit_qps"`
+	RetryCount              int64             `tfschema:"retry_count"`
+	TimeoutInSeconds        int64             `tfschema:"timeout_in_seconds"`
+	StartTimeAttributeName  string            `tfschema:"start_time_attribute_name"`
+	EndTimeAttributeName    string            `tfschema:"end_time_attribute_name"`
+}
+
+type DataConnectorRestApiPollerDcrModel struct {
+	DataCollectionEndpoint        string `tfschema:"data_collection_endpoint"`
+	DataCollectionRuleImmutableId string `tfschema:"data_collection_rule_immutable_id"`
+	StreamName                    string `tfschema:"stream_name"`
+}
+
+type DataConnectorRestApiPollerPagingModel struct {
+	Type                  string `tfschema:"type"`
+	PageSize              int64  `tfschema:"page_size"`
+	PageSizeParameterName string `tfschema:"page_size_parameter_name"`
+}
+
+type DataConnectorRestApiPollerResponseModel struct {
+	EventsJsonPaths       []string `tfschema:"events_json_paths"`
+	Format                string   `tfschema:"format"`
+	SuccessStatusJsonPath string   `tfschema:"success_status_json_path"`
+	SuccessStatusValue    string   `tfschema:"success_status_value"`
+	IsGzipCompressed      bool     `tfschema:"is_gzip_compressed"`
+}
+
+type DataConnectorRestApiPollerResource struct{}
+
+var _ sdk.ResourceWithUpdate = DataConnectorRestApiPollerResource{}
+
+func (r DataConnectorRestApiPollerResource) ResourceType() string {
+	return "azurerm_sentinel_data_connector_rest_api_poller"
+}
+
+func (r DataConnectorRestApiPollerResource) ModelObject() interface{} {
+	return &DataConnectorRestApiPollerModel{}
+}
+
+func (r DataConnectorRestApiPollerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return dataconnectors.ValidateDataConnectorID
+}
+
+func (r DataConnectorRestApiPollerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"connector_definition_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		// the authentication configuration is a discriminated type containing secrets which aren't returned by the API,
+		// so it's specified as JSON rather than modelled in the schema
+		"auth_json": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			Sensitive:        true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"request": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"api_endpoint": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"http_method": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(dataconnectors.HTTPMethodVerbGET),
+						ValidateFunc: validation.StringInSlice(dataconnectors.PossibleValuesForHTTPMethodVerb(), false),
+					},
+
+					"headers": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"query_parameters": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"query_parameters_template": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"query_time_format": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"query_window_in_minutes": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"rate_limit_qps": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"retry_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"timeout_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"start_time_attribute_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"end_time_attribute_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"dcr": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"data_collection_endpoint": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+
+					"data_collection_rule_immutable_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"stream_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"data_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"paging": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(dataconnectors.PossibleValuesForRestApiPollerRequestPagingKind(), false),
+					},
+
+					"page_size": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"page_size_parameter_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"response": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"events_json_paths": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"format": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  "json",
+						ValidateFunc: validation.StringInSlice([]string{
+							"csv",
+							"json",
+							"xml",
+						}, false),
+					},
+
+					"success_status_json_path": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"success_status_value": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"is_gzip_compressed": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"add_on_attributes": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"active": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r DataConnectorRestApiPollerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DataConnectorRestApiPollerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.CodelessDataConnectorsClient
+
+			var plan DataConnectorRestApiPollerModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(plan.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := dataconnectors.NewDataConnectorID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, plan.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input, err := expandDataConnectorRestApiPoller(plan)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, *input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DataConnectorRestApiPollerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.CodelessDataConnectorsClient
+
+			id, err := dataconnectors.ParseDataConnectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config DataConnectorRestApiPollerModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := DataConnectorRestApiPollerModel{
+				Name:                    id.DataConnectorId,
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+				// the secrets within the authentication configuration aren't returned by the API
+				AuthJson: config.AuthJson,
+			}
+
+			if resp.Model != nil {
+				model, ok := resp.Model.(dataconnectors.RestApiPollerDataConnector)
+				if !ok {
+					return fmt.Errorf("%s was of kind %q rather than %q", *id, resp.Model.DataConnector().Kind, dataconnectors.DataConnectorKindRestApiPoller)
+				}
+
+				if props := model.Properties; props != nil {
+					state.ConnectorDefinitionName = props.ConnectorDefinitionName
+					state.DataType = pointer.From(props.DataType)
+					// the Data Connector is active unless specified otherwise
+					state.Active = true
+					if props.IsActive != nil {
+						state.Active = *props.IsActive
+					}
+					state.AddOnAttributes = pointer.From(props.AddOnAttributes)
+					state.Dcr = flattenDataConnectorRestApiPollerDcr(props.DcrConfig)
+					state.Paging = flattenDataConnectorRestApiPollerPaging(props.Paging)
+					state.Response = flattenDataConnectorRestApiPollerResponse(props.Response)
+
+					request, err := flattenDataConnectorRestApiPollerRequest(props.Request)
+					if err != nil {
+						return fmt.Errorf("flattening `request`: %+v", err)
+					}
+					state.Request = request
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DataConnectorRestApiPollerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.CodelessDataConnectorsClient
+
+			id, err := dataconnectors.ParseDataConnectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var plan DataConnectorRestApiPollerModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// the authentication configuration isn't returned by the API, so the whole Data Connector is sent
+			input, err := expandDataConnectorRestApiPoller(plan)
+			if err != nil {
+				return err
+			}
+			input.Etag = existing.Model.DataConnector().Etag
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *input); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DataConnectorRestApiPollerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.CodelessDataConnectorsClient
+
+			id, err := dataconnectors.ParseDataConnectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDataConnectorRestApiPoller(input DataConnectorRestApiPollerModel) (*dataconnectors.RestApiPollerDataConnector, error) {
+	auth, err := dataconnectors.UnmarshalCcpAuthConfigImplementation([]byte(input.AuthJson))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `auth_json`: %+v", err)
+	}
+	if _, ok := auth.(dataconnectors.RawCcpAuthConfigImpl); ok {
+		return nil, fmt.Errorf("parsing `auth_json`: `type` must be one of: %v", dataconnectors.PossibleValuesForCcpAuthType())
+	}
+
+	props := &dataconnectors.RestApiPollerDataConnectorProperties{
+		Auth:                    auth,
+		ConnectorDefinitionName: input.ConnectorDefinitionName,
+		DcrConfig:               expandDataConnectorRestApiPollerDcr(input.Dcr),
+		IsActive:                pointer.To(input.Active),
+		Paging:                  expandDataConnectorRestApiPollerPaging(input.Paging),
+		Request:                 expandDataConnectorRestApiPollerRequest(input.Request),
+		Response:                expandDataConnectorRestApiPollerResponse(input.Response),
+	}
+
+	if input.DataType != "" {
+		props.DataType = pointer.To(input.DataType)
+	}
+
+	if len(input.AddOnAttributes) > 0 {
+		props.AddOnAttributes = pointer.To(input.AddOnAttributes)
+	}
+
+	return &dataconnectors.RestApiPollerDataConnector{
+		Properties: props,
+	}, nil
+}
+
+func expandDataConnectorRestApiPollerRequest(input []DataConnectorRestApiPollerRequestModel) dataconnectors.RestApiPollerRequestConfig {
+	if len(input) == 0 {
+		return dataconnectors.RestApiPollerRequestConfig{}
+	}
+	v := input[0]
+
+	output := dataconnectors.RestApiPollerRequestConfig{
+		ApiEndpoint: v.ApiEndpoint,
+		HTTPMethod:  pointer.To(dataconnectors.HTTPMethodVerb(v.HttpMethod)),
+	}
+
+	if len(v.Headers) > 0 {
+		output.Headers = pointer.To(v.Headers)
+	}
+
+	if len(v.QueryParameters) > 0 {
+		queryParameters := make(map[string]interface{}, len(v.QueryParameters))
+		for key, value := range v.QueryParameters {
+			queryParameters[key] = value
+		}
+		output.QueryParameters = pointer.To(queryParameters)
+	}
+
+	if v.QueryParametersTemplate != "" {
+		output.QueryParametersTemplate = pointer.To(v.QueryParametersTemplate)
+	}
+
+	if v.QueryTimeFormat != "" {
+		output.QueryTimeFormat = pointer.To(v.QueryTimeFormat)
+	}
+
+	if v.QueryWindowInMinutes != 0 {
+		output.QueryWindowInMin = pointer.To(v.QueryWindowInMinutes)
+	}
+
+	if v.RateLimitQps != 0 {
+		output.RateLimitQPS = pointer.To(v.RateLimitQps)
+	}
+
+	if v.RetryCount != 0 {
+		output.RetryCount = pointer.To(v.RetryCount)
+	}
+
+	if v.TimeoutInSeconds != 0 {
+		output.TimeoutInSeconds = pointer.To(v.TimeoutInSeconds)
+	}
+
+	if v.StartTimeAttributeName != "" {
+		output.StartTimeAttributeName = pointer.To(v.StartTimeAttributeName)
+	}
+
+	if v.EndTimeAttributeName != "" {
+		output.EndTimeAttributeName = pointer.To(v.EndTimeAttributeName)
+	}
+
+	return output
+}
+
+func flattenDataConnectorRestApiPollerRequest(input dataconnectors.RestApiPollerRequestConfig) ([]DataConnectorRestApiPollerRequestModel, error) {
+	output := DataConnectorRestApiPollerRequestModel{
+		ApiEndpoint:             input.ApiEndpoint,
+		HttpMethod:              string(pointer.From(input.HTTPMethod)),
+		Headers:                 pointer.From(input.Headers),
+		QueryParametersTemplate: pointer.From(input.QueryParametersTemplate),
+		QueryTimeFormat:         pointer.From(input.QueryTimeFormat),
+		QueryWindowInMinutes:    pointer.From(input.QueryWindowInMin),
+		RateLimitQps:            pointer.From(input.RateLimitQPS),
+		RetryCount:              pointer.From(input.RetryCount),
+		TimeoutInSeconds:        pointer.From(input.TimeoutInSeconds),
+		StartTimeAttributeName:  pointer.From(input.StartTimeAttributeName),
+		EndTimeAttributeName:    pointer.From(input.EndTimeAttributeName),
+	}
+
+	if input.QueryParameters != nil {
+		output.QueryParameters = make(map[string]string, len(*input.QueryParameters))
+		for key, value := range *input.QueryParameters {
+			// values other than strings are represented using their JSON encoding
+			if v, ok := value.(string); ok {
+				output.QueryParameters[key] = v
+				continue
+			}
+			v, err := json.Marshal(value)
+			if err != nil {
+				return nil, fmt.Errorf("marshaling the query parameter %q: %+v", key, err)
+			}
+			output.QueryParameters[key] = string(v)
+		}
+	}
+
+	return []DataConnectorRestApiPollerRequestModel{output}, nil
+}
+
+func expandDataConnectorRestApiPollerDcr(input []DataConnectorRestApiPollerDcrModel) *dataconnectors.DCRConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &dataconnectors.DCRConfiguration{
+		DataCollectionEndpoint:        input[0].DataCollectionEndpoint,
+		DataCollectionRuleImmutableId: input[0].DataCollectionRuleImmutableId,
+		StreamName:                    input[0].StreamName,
+	}
+}
+
+func flattenDataConnectorRestApiPollerDcr(input *dataconnectors.DCRConfiguration) []DataConnectorRestApiPollerDcrModel {
+	if input == nil {
+		return []DataConnectorRestApiPollerDcrModel{}
+	}
+
+	return []DataConnectorRestApiPollerDcrModel{
+		{
+			DataCollectionEndpoint:        input.DataCollectionEndpoint,
+			DataCollectionRuleImmutableId: input.DataCollectionRuleImmutableId,
+			StreamName:                    input.StreamName,
+		},
+	}
+}
+
+func expandDataConnectorRestApiPollerPaging(input []DataConnectorRestApiPollerPagingModel) *dataconnectors.RestApiPollerRequestPagingConfig {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := &dataconnectors.RestApiPollerRequestPagingConfig{
+		PagingType: dataconnectors.RestApiPollerRequestPagingKind(input[0].Type),
+	}
+
+	if input[0].PageSize != 0 {
+		output.PageSize = pointer.To(input[0].PageSize)
+	}
+
+	if input[0].PageSizeParameterName != "" {
+		output.PageSizeParameterName = pointer.To(input[0].PageSizeParameterName)
+	}
+
+	return output
+}
+
+func flattenDataConnectorRestApiPollerPaging(input *dataconnectors.RestApiPollerRequestPagingConfig) []DataConnectorRestApiPollerPagingModel {
+	if input == nil {
+		return []DataConnectorRestApiPollerPagingModel{}
+	}
+
+	return []DataConnectorRestApiPollerPagingModel{
+		{
+			Type:                  string(input.PagingType),
+			PageSize:              pointer.From(input.PageSize),
+			PageSizeParameterName: pointer.From(input.PageSizeParameterName),
+		},
+	}
+}
+
+func expandDataConnectorRestApiPollerResponse(input []DataConnectorRestApiPollerResponseModel) *dataconnectors.CcpResponseConfig {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := &dataconnectors.CcpResponseConfig{
+		EventsJsonPaths:  input[0].EventsJsonPaths,
+		Format:           pointer.To(input[0].Format),
+		IsGzipCompressed: pointer.To(input[0].IsGzipCompressed),
+	}
+
+	if input[0].SuccessStatusJsonPath != "" {
+		output.SuccessStatusJsonPath = pointer.To(input[0].SuccessStatusJsonPath)
+	}
+
+	if input[0].SuccessStatusValue != "" {
+		output.SuccessStatusValue = pointer.To(input[0].SuccessStatusValue)
+	}
+
+	return output
+}
+
+func flattenDataConnectorRestApiPollerResponse(input *dataconnectors.CcpResponseConfig) []DataConnectorRestApiPollerResponseModel {
+	if input == nil {
+		return []DataConnectorRestApiPollerResponseModel{}
+	}
+
+	format := "json"
+	if input.Format != nil {
+		format = *input.Format
+	}
+
+	return []DataConnectorRestApiPollerResponseModel{
+		{
+			EventsJsonPaths:       input.EventsJsonPaths,
+			Format:                format,
+			SuccessStatusJsonPath: pointer.From(input.SuccessStatusJsonPath),
+			SuccessStatusValue:    pointer.From(input.SuccessStatusValue),
+			IsGzipCompressed:      pointer.From(input.IsGzipCompressed),
+		},
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/dataconnectors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SentinelDataConnectorRestApiPollerResource struct{}
+
+func TestAccSentinelDataConnectorRestApiPoller_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_rest_api_poller", "test")
+	r := SentinelDataConnectorRestApiPollerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("auth_json"),
+	})
+}
+
+func TestAccSentinelDataConnectorRestApiPoller_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_rest_api_poller", "test")
+	r := SentinelDataConnectorRestApiPollerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("auth_json"),
+	})
+}
+
+func TestAccSentinelDataConnectorRestApiPoller_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_rest_api_poller", "test")
+	r := SentinelDataConnectorRestApiPollerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("auth_json"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("auth_json"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("auth_json"),
+	})
+}
+
+func TestAccSentinelDataConnectorRestApiPoller_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_rest_api_poller", "test")
+	r := SentinelDataConnectorRestApiPollerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SentinelDataConnectorRestApiPollerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := dataconnectors.ParseDataConnectorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Sentinel.CodelessDataConnectorsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r SentinelDataConnectorRestApiPollerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_data_connector_rest_api_poller" "test" {
+  name                       = "acctest-dc-%d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  connector_definition_name  = azurerm_sentinel_data_connector_definition.test.name
+  data_type                  = azurerm_log_analytics_workspace_table_custom_log.test.name
+
+  auth_json = jsonencode({
+    type       = "APIKey"
+    apiKeyName = "Authorization"
+    apiKey     = "acceptance-test-key"
+  })
+
+  request {
+    api_endpoint = "https://api.example.com/v1/events"
+  }
+
+  dcr {
+    data_collection_endpoint          = azurerm_monitor_data_collection_endpoint.test.logs_ingestion_endpoint
+    data_collection_rule_immutable_id = azurerm_monitor_data_collection_rule.test.immutable_id
+    stream_name                       = "Custom-AcceptanceTestStream"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SentinelDataConnectorRestApiPollerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_data_connector_rest_api_poller" "test" {
+  name                       = "acctest-dc-%d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  connector_definition_name  = azurerm_sentinel_data_connector_definition.test.name
+  data_type                  = azurerm_log_analytics_workspace_table_custom_log.test.name
+  active                     = false
+
+  auth_json = jsonencode({
+    type       = "APIKey"
+    apiKeyName = "Authorization"
+    apiKey     = "acceptance-test-key-updated"
+  })
+
+  request {
+    api_endpoint              = "https://api.example.com/v2/events"
+    http_method               = "GET"
+    query_time_format         = "yyyy-MM-ddTHH:mm:ssZ"
+    query_window_in_minutes   = 10
+    rate_limit_qps            = 5
+    retry_count               = 3
+    timeout_in_seconds        = 60
+    start_time_attribute_name = "since"
+    end_time_attribute_name   = "until"
+
+    headers = {
+      Accept = "application/json"
+    }
+
+    query_parameters = {
+      severity = "high"
+    }
+  }
+
+  paging {
+    type                     = "LinkHeader"
+    page_size                = 100
+    page_size_parameter_name = "limit"
+  }
+
+  response {
+    events_json_paths        = ["$.events"]
+    format                   = "json"
+    success_status_json_path = "$.status"
+    success_status_value     = "ok"
+  }
+
+  dcr {
+    data_collection_endpoint          = azurerm_monitor_data_collection_endpoint.test.logs_ingestion_endpoint
+    data_collection_rule_immutable_id = azurerm_monitor_data_collection_rule.test.immutable_id
+    stream_name                       = "Custom-AcceptanceTestStream"
+  }
+
+  add_on_attributes = {
+    Source = "AcceptanceTests"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SentinelDataConnectorRestApiPollerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_data_connector_rest_api_poller" "import" {
+  name                       = azurerm_sentinel_data_connector_rest_api_poller.test.name
+  log_analytics_workspace_id = azurerm_sentinel_data_connector_rest_api_poller.test.log_analytics_workspace_id
+  connector_definition_name  = azurerm_sentinel_data_connector_rest_api_poller.test.connector_definition_name
+  data_type                  = azurerm_sentinel_data_connector_rest_api_poller.test.data_type
+  auth_json                  = azurerm_sentinel_data_connector_rest_api_poller.test.auth_json
+
+  request {
+    api_endpoint = "https://api.example.com/v1/events"
+  }
+
+  dcr {
+    data_collection_endpoint          = azurerm_monitor_data_collection_endpoint.test.logs_ingestion_endpoint
+    data_collection_rule_immutable_id = azurerm_monitor_data_collection_rule.test.immutable_id
+    stream_name                       = "Custom-AcceptanceTestStream"
+  }
+}
+`, r.basic(data))
+}
+
+func (SentinelDataConnectorRestApiPollerResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_log_analytics_workspace_table_custom_log" "test" {
+  name         = "AcceptanceTest%[2]d_CL"
+  workspace_id = azurerm_log_analytics_workspace.test.id
+
+  column {
+    name = "TimeGenerated"
+    type = "dateTime"
+  }
+
+  column {
+    name = "Message"
+    type = "string"
+  }
+}
+
+resource "azurerm_monitor_data_collection_endpoint" "test" {
+  name                = "acctestmdce-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_monitor_data_collection_rule" "test" {
+  name                        = "acctestmdcr-%[2]d"
+  resource_group_name         = azurerm_resource_group.test.name
+  location                    = azurerm_resource_group.test.location
+  data_collection_endpoint_id = azurerm_monitor_data_collection_endpoint.test.id
+
+  destinations {
+    log_analytics {
+      workspace_resource_id = azurerm_log_analytics_workspace.test.id
+      name                  = "test-destination-log"
+    }
+  }
+
+  data_flow {
+    streams       = ["Custom-AcceptanceTestStream"]
+    destinations  = ["test-destination-log"]
+    output_stream = "Custom-${azurerm_log_analytics_workspace_table_custom_log.test.name}"
+    transform_kql = "source"
+  }
+
+  stream_declaration {
+    stream_name = "Custom-AcceptanceTestStream"
+    column {
+      name = "TimeGenerated"
+      type = "datetime"
+    }
+    column {
+      name = "Message"
+      type = "string"
+    }
+  }
+}
+`, SentinelDataConnectorDefinitionResource{}.basic(data, "Acceptance Test Connector"), data.RandomInteger)
+}
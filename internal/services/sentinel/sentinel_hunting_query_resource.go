@@ -0,0 +1,493 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/savedsearches"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// Sentinel Hunting Queries are stored as Saved Searches within the Log Analytics Workspace, using a well-known category
+// and with the description, tactics, techniques and entity mappings stored as tags - as the Portal does. The entity
+// mappings are stored as JSON, in the same format as the entity mappings of a Scheduled Alert Rule.
+const (
+	huntingQueryCategory          = "Hunting Queries"
+	huntingQueryTagDescription    = "description"
+	huntingQueryTagEntityMappings = "entityMappings"
+	huntingQueryTagTactics        = "tactics"
+	huntingQueryTagTechniques     = "techniques"
+)
+
+type HuntingQueryModel struct {
+	Name                    string                           `tfschema:"name"`
+	LogAnalyticsWorkspaceId string                           `tfschema:"log_analytics_workspace_id"`
+	DisplayName             string                           `tfschema:"display_name"`
+	Query                   string                           `tfschema:"query"`
+	Description             string                           `tfschema:"description"`
+	Tactics                 []string                         `tfschema:"tactics"`
+	Techniques              []string                         `tfschema:"techniques"`
+	EntityMapping           []HuntingQueryEntityMappingModel `tfschema:"entity_mapping"`
+}
+
+type HuntingQueryEntityMappingModel struct {
+	EntityType   string                          `tfschema:"entity_type"`
+	FieldMapping []HuntingQueryFieldMappingModel `tfschema:"field_mapping"`
+}
+
+type HuntingQueryFieldMappingModel struct {
+	Identifier string `tfschema:"identifier"`
+	ColumnName string `tfschema:"column_name"`
+}
+
+type HuntingQueryResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate         = HuntingQueryResource{}
+	_ sdk.ResourceWithCustomImporter = HuntingQueryResource{}
+)
+
+func (r HuntingQueryResource) ResourceType() string {
+	return "azurerm_sentinel_hunting_query"
+}
+
+func (r HuntingQueryResource) ModelObject() interface{} {
+	return &HuntingQueryModel{}
+}
+
+func (r HuntingQueryResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return savedsearches.ValidateSavedSearchID
+}
+
+func (r HuntingQueryResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tactics": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(alertrules.PossibleValuesForAttackTactic(), false),
+			},
+		},
+
+		"techniques": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"entity_mapping": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"entity_type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(alertrules.PossibleValuesForEntityMappingType(), false),
+					},
+
+					"field_mapping": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 3,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"identifier": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"column_name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r HuntingQueryResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r HuntingQueryResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		id, err := savedsearches.ParseSavedSearchID(metadata.ResourceData.Id())
+		if err != nil {
+			return err
+		}
+
+		resp, err := metadata.Client.LogAnalytics.SavedSearchesClient.Get(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		if resp.Model == nil || !strings.EqualFold(resp.Model.Properties.Category, huntingQueryCategory) {
+			return fmt.Errorf("%s is not a Sentinel Hunting Query - the category must be %q", *id, huntingQueryCategory)
+		}
+
+		return nil
+	}
+}
+
+func (r HuntingQueryResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SavedSearchesClient
+
+			var plan HuntingQueryModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(plan.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := savedsearches.NewSavedSearchID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, plan.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := savedsearches.SavedSearch{
+				Properties: savedsearches.SavedSearchProperties{
+					Category:    huntingQueryCategory,
+					DisplayName: plan.DisplayName,
+					Query:       plan.Query,
+				},
+			}
+
+			tags, err := expandHuntingQueryTags(plan)
+			if err != nil {
+				return err
+			}
+			input.Properties.Tags = tags
+
+			if _, err := client.CreateOrUpdate(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r HuntingQueryResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SavedSearchesClient
+
+			id, err := savedsearches.ParseSavedSearchID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := HuntingQueryModel{
+				Name:                    id.SavedSearchId,
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.DisplayName = model.Properties.DisplayName
+				state.Query = model.Properties.Query
+				state.Description, state.Tactics, state.Techniques, state.EntityMapping = flattenHuntingQueryTags(model.Properties.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r HuntingQueryResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SavedSearchesClient
+
+			id, err := savedsearches.ParseSavedSearchID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var plan HuntingQueryModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			input := *existing.Model
+			if metadata.ResourceData.HasChange("display_name") {
+				input.Properties.DisplayName = plan.DisplayName
+			}
+			if metadata.ResourceData.HasChange("query") {
+				input.Properties.Query = plan.Query
+			}
+			if metadata.ResourceData.HasChanges("description", "tactics", "techniques", "entity_mapping") {
+				tags, err := expandHuntingQueryTags(plan)
+				if err != nil {
+					return err
+				}
+				input.Properties.Tags = mergeHuntingQueryTags(input.Properties.Tags, tags)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, input); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r HuntingQueryResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SavedSearchesClient
+
+			id, err := savedsearches.ParseSavedSearchID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandHuntingQueryTags(input HuntingQueryModel) (*[]savedsearches.Tag, error) {
+	tags := make([]savedsearches.Tag, 0)
+
+	if input.Description != "" {
+		tags = append(tags, savedsearches.Tag{
+			Name:  huntingQueryTagDescription,
+			Value: input.Description,
+		})
+	}
+
+	if len(input.Tactics) > 0 {
+		tactics := append([]string{}, input.Tactics...)
+		sort.Strings(tactics)
+		tags = append(tags, savedsearches.Tag{
+			Name:  huntingQueryTagTactics,
+			Value: strings.Join(tactics, ","),
+		})
+	}
+
+	if len(input.Techniques) > 0 {
+		techniques := append([]string{}, input.Techniques...)
+		sort.Strings(techniques)
+		tags = append(tags, savedsearches.Tag{
+			Name:  huntingQueryTagTechniques,
+			Value: strings.Join(techniques, ","),
+		})
+	}
+
+	if len(input.EntityMapping) > 0 {
+		value, err := json.Marshal(expandHuntingQueryEntityMappings(input.EntityMapping))
+		if err != nil {
+			return nil, fmt.Errorf("marshaling `entity_mapping`: %+v", err)
+		}
+		tags = append(tags, savedsearches.Tag{
+			Name:  huntingQueryTagEntityMappings,
+			Value: string(value),
+		})
+	}
+
+	return &tags, nil
+}
+
+// mergeHuntingQueryTags replaces the tags managed by this resource, retaining any other tags on the Saved Search
+func mergeHuntingQueryTags(existing *[]savedsearches.Tag, managed *[]savedsearches.Tag) *[]savedsearches.Tag {
+	tags := make([]savedsearches.Tag, 0)
+
+	if existing != nil {
+		for _, tag := range *existing {
+			if isHuntingQueryManagedTag(tag.Name) {
+				continue
+			}
+			tags = append(tags, tag)
+		}
+	}
+
+	tags = append(tags, pointer.From(managed)...)
+
+	return &tags
+}
+
+// isHuntingQueryManagedTag returns whether the tag on the Saved Search is one of those managed by this resource
+func isHuntingQueryManagedTag(name string) bool {
+	for _, v := range []string{huntingQueryTagDescription, huntingQueryTagEntityMappings, huntingQueryTagTactics, huntingQueryTagTechniques} {
+		if strings.EqualFold(name, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func flattenHuntingQueryTags(input *[]savedsearches.Tag) (description string, tactics []string, techniques []string, entityMappings []HuntingQueryEntityMappingModel) {
+	tactics = make([]string, 0)
+	techniques = make([]string, 0)
+	entityMappings = make([]HuntingQueryEntityMappingModel, 0)
+	if input == nil {
+		return
+	}
+
+	for _, tag := range *input {
+		switch {
+		case strings.EqualFold(tag.Name, huntingQueryTagDescription):
+			description = tag.Value
+		case strings.EqualFold(tag.Name, huntingQueryTagTactics):
+			tactics = splitHuntingQueryTagValue(tag.Value)
+		case strings.EqualFold(tag.Name, huntingQueryTagTechniques):
+			techniques = splitHuntingQueryTagValue(tag.Value)
+		case strings.EqualFold(tag.Name, huntingQueryTagEntityMappings):
+			// a value which can't be parsed shows up as a diff, and is replaced when this is next applied
+			var mappings []alertrules.EntityMapping
+			if err := json.Unmarshal([]byte(tag.Value), &mappings); err != nil {
+				log.Printf("[DEBUG] unable to parse the %q tag of the Hunting Query: %+v", tag.Name, err)
+				continue
+			}
+			entityMappings = flattenHuntingQueryEntityMappings(mappings)
+		}
+	}
+
+	return
+}
+
+func expandHuntingQueryEntityMappings(input []HuntingQueryEntityMappingModel) []alertrules.EntityMapping {
+	output := make([]alertrules.EntityMapping, 0, len(input))
+	for _, v := range input {
+		fieldMappings := make([]alertrules.FieldMapping, 0, len(v.FieldMapping))
+		for _, f := range v.FieldMapping {
+			fieldMappings = append(fieldMappings, alertrules.FieldMapping{
+				Identifier: pointer.To(f.Identifier),
+				ColumnName: pointer.To(f.ColumnName),
+			})
+		}
+
+		output = append(output, alertrules.EntityMapping{
+			EntityType:    pointer.To(alertrules.EntityMappingType(v.EntityType)),
+			FieldMappings: pointer.To(fieldMappings),
+		})
+	}
+
+	return output
+}
+
+func flattenHuntingQueryEntityMappings(input []alertrules.EntityMapping) []HuntingQueryEntityMappingModel {
+	output := make([]HuntingQueryEntityMappingModel, 0, len(input))
+	for _, v := range input {
+		fieldMappings := make([]HuntingQueryFieldMappingModel, 0)
+		for _, f := range pointer.From(v.FieldMappings) {
+			fieldMappings = append(fieldMappings, HuntingQueryFieldMappingModel{
+				Identifier: pointer.From(f.Identifier),
+				ColumnName: pointer.From(f.ColumnName),
+			})
+		}
+
+		output = append(output, HuntingQueryEntityMappingModel{
+			EntityType:   string(pointer.From(v.EntityType)),
+			FieldMapping: fieldMappings,
+		})
+	}
+
+	return output
+}
+
+func splitHuntingQueryTagValue(input string) []string {
+	output := make([]string, 0)
+	for _, v := range strings.Split(input, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			output = append(output, v)
+		}
+	}
+	return output
+}
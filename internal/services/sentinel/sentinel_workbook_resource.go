@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	workbooks "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-04-01/workbooksapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	applicationInsightsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// Sentinel Workbooks are Application Insights Workbooks within the resource group of the Log Analytics Workspace, using
+// the Workspace as the source and a well-known category
+const sentinelWorkbookCategory = "sentinel"
+
+type WorkbookModel struct {
+	Name                    string            `tfschema:"name"`
+	LogAnalyticsWorkspaceId string            `tfschema:"log_analytics_workspace_id"`
+	DisplayName             string            `tfschema:"display_name"`
+	DataJson                string            `tfschema:"data_json"`
+	Description             string            `tfschema:"description"`
+	Tags                    map[string]string `tfschema:"tags"`
+}
+
+type WorkbookResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate         = WorkbookResource{}
+	_ sdk.ResourceWithCustomImporter = WorkbookResource{}
+)
+
+func (r WorkbookResource) ResourceType() string {
+	return "azurerm_sentinel_workbook"
+}
+
+func (r WorkbookResource) ModelObject() interface{} {
+	return &WorkbookModel{}
+}
+
+func (r WorkbookResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workbooks.ValidateWorkbookID
+}
+
+func (r WorkbookResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.IsUUID,
+				applicationInsightsValidate.StringDoesNotContainUpperCaseLetter,
+			),
+		},
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"data_json": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": {
+			Type:         pluginsdk.TypeMap,
+			Optional:     true,
+			ValidateFunc: applicationInsightsValidate.WorkbookTags,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r WorkbookResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WorkbookResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		id, err := workbooks.ParseWorkbookID(metadata.ResourceData.Id())
+		if err != nil {
+			return err
+		}
+
+		resp, err := metadata.Client.AppInsights.WorkbookClient.WorkbooksGet(ctx, *id, workbooks.WorkbooksGetOperationOptions{CanFetchContent: pointer.To(false)})
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil || !strings.EqualFold(resp.Model.Properties.Category, sentinelWorkbookCategory) {
+			return fmt.Errorf("%s is not a Sentinel Workbook - the category must be %q", *id, sentinelWorkbookCategory)
+		}
+
+		return nil
+	}
+}
+
+func (r WorkbookResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.WorkbookClient
+
+			var plan WorkbookModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(plan.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := workbooks.NewWorkbookID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, plan.Name)
+
+			existing, err := client.WorkbooksGet(ctx, id, workbooks.WorkbooksGetOperationOptions{CanFetchContent: pointer.To(false)})
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the Workbook must be in the same region as the Workspace for it to be shown within Sentinel
+			workspace, err := metadata.Client.LogAnalytics.WorkspaceClient.Get(ctx, *workspaceId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *workspaceId, err)
+			}
+			if workspace.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *workspaceId)
+			}
+
+			sourceId := strings.ToLower(workspaceId.ID())
+			input := workbooks.Workbook{
+				Kind:     pointer.To(workbooks.WorkbookSharedTypeKindShared),
+				Location: location.Normalize(workspace.Model.Location),
+				Properties: &workbooks.WorkbookProperties{
+					Category:       sentinelWorkbookCategory,
+					DisplayName:    plan.DisplayName,
+					SerializedData: plan.DataJson,
+					SourceId:       pointer.To(sourceId),
+				},
+				Tags: pointer.To(plan.Tags),
+			}
+
+			if plan.Description != "" {
+				input.Properties.Description = pointer.To(plan.Description)
+			}
+
+			if _, err := client.WorkbooksCreateOrUpdate(ctx, id, input, workbooks.WorkbooksCreateOrUpdateOperationOptions{SourceId: pointer.To(sourceId)}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WorkbookResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.WorkbookClient
+
+			id, err := workbooks.ParseWorkbookID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.WorkbooksGet(ctx, *id, workbooks.WorkbooksGetOperationOptions{CanFetchContent: pointer.To(true)})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := WorkbookModel{
+				Name: id.WorkbookName,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					// the source of the Workbook is returned in lower-case
+					workspaceId, err := workspaces.ParseWorkspaceIDInsensitively(pointer.From(props.SourceId))
+					if err != nil {
+						return fmt.Errorf("parsing the source of %s as a Log Analytics Workspace ID: %+v", *id, err)
+					}
+					state.LogAnalyticsWorkspaceId = workspaceId.ID()
+					state.DisplayName = props.DisplayName
+					state.DataJson = props.SerializedData
+					state.Description = pointer.From(props.Description)
+				}
+
+				if model.Tags != nil {
+					// the API adds a `hidden-title` tag containing the display name, which isn't managed by this resource
+					delete(*model.Tags, "hidden-title")
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WorkbookResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.WorkbookClient
+
+			id, err := workbooks.ParseWorkbookID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var plan WorkbookModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.WorkbooksGet(ctx, *id, workbooks.WorkbooksGetOperationOptions{CanFetchContent: pointer.To(true)})
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			input := *existing.Model
+			if metadata.ResourceData.HasChange("display_name") {
+				input.Properties.DisplayName = plan.DisplayName
+				if input.Tags != nil {
+					delete(*input.Tags, "hidden-title")
+				}
+			}
+
+			if metadata.ResourceData.HasChange("data_json") {
+				input.Properties.SerializedData = plan.DataJson
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				input.Properties.Description = pointer.To(plan.Description)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				input.Tags = pointer.To(plan.Tags)
+			}
+
+			options := workbooks.WorkbooksCreateOrUpdateOperationOptions{
+				SourceId: input.Properties.SourceId,
+			}
+			if _, err := client.WorkbooksCreateOrUpdate(ctx, *id, input, options); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WorkbookResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.WorkbookClient
+
+			id, err := workbooks.ParseWorkbookID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.WorkbooksDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/md5" // nolint: gosec only used for change detection and the API exposes md5
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/containers"
+)
+
+// blobDirectoryContentTypes is the built-in lookup used to guess the Content Type of a file from its extension.
+// `mime.TypeByExtension` isn't used since it consults the mime files on the host, meaning the same configuration
+// could plan differently on different machines.
+var blobDirectoryContentTypes = map[string]string{
+	".avif":        "image/avif",
+	".css":         "text/css; charset=utf-8",
+	".csv":         "text/csv; charset=utf-8",
+	".gif":         "image/gif",
+	".gz":          "application/gzip",
+	".htm":         "text/html; charset=utf-8",
+	".html":        "text/html; charset=utf-8",
+	".ico":         "image/vnd.microsoft.icon",
+	".jpeg":        "image/jpeg",
+	".jpg":         "image/jpeg",
+	".js":          "text/javascript; charset=utf-8",
+	".json":        "application/json",
+	".map":         "application/json",
+	".md":          "text/markdown; charset=utf-8",
+	".mjs":         "text/javascript; charset=utf-8",
+	".mp3":         "audio/mpeg",
+	".mp4":         "video/mp4",
+	".otf":         "font/otf",
+	".pdf":         "application/pdf",
+	".png":         "image/png",
+	".svg":         "image/svg+xml",
+	".tar":         "application/x-tar",
+	".ttf":         "font/ttf",
+	".txt":         "text/plain; charset=utf-8",
+	".wasm":        "application/wasm",
+	".webmanifest": "application/manifest+json",
+	".webm":        "video/webm",
+	".webp":        "image/webp",
+	".woff":        "font/woff",
+	".woff2":       "font/woff2",
+	".xml":         "application/xml",
+	".yaml":        "application/yaml",
+	".yml":         "application/yaml",
+	".zip":         "application/zip",
+}
+
+// blobDirectoryContentType returns the Content Type for the file at the relative path `name`, preferring
+// any user-specified override for the extension, then the built-in lookup, then the default.
+func blobDirectoryContentType(name string, overrides map[string]string, defaultContentType string) string {
+	ext := strings.ToLower(path.Ext(name))
+	if ext == "" {
+		return defaultContentType
+	}
+
+	for k, v := range overrides {
+		if strings.EqualFold(strings.TrimPrefix(k, "."), ext[1:]) {
+			return v
+		}
+	}
+
+	if v, ok := blobDirectoryContentTypes[ext]; ok {
+		return v
+	}
+
+	return defaultContentType
+}
+
+// blobDirectoryLocalFiles walks the directory at `source` and returns a map of the relative path (using forward
+// slashes) of each regular file to the hex-encoded MD5 of its contents.
+func blobDirectoryLocalFiles(source string) (map[string]string, error) {
+	files := make(map[string]string)
+	err := filepath.WalkDir(source, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		relative, err := filepath.Rel(source, p)
+		if err != nil {
+			return err
+		}
+
+		hash, err := blobDirectoryFileMD5(p)
+		if err != nil {
+			return err
+		}
+
+		files[filepath.ToSlash(relative)] = hash
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading directory %q: %+v", source, err)
+	}
+
+	return files, nil
+}
+
+func blobDirectoryFileMD5(name string) (string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New() // nolint: gosec
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("hashing %q: %+v", name, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// blobDirectoryContentMD5 returns a single hash representing the names and contents of all of the files
+func blobDirectoryContentMD5(files map[string]string) string {
+	names := make([]string, 0, len(files))
+	for k := range files {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	hash := md5.New() // nolint: gosec
+	for _, name := range names {
+		hash.Write([]byte(fmt.Sprintf("%s:%s\n", name, files[name])))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// blobDirectoryRemoteFiles lists the Blobs within the Container starting with `prefix` and returns a map of the
+// name of each Blob (with the prefix removed) to the hex-encoded MD5 of its contents. Blobs which don't have a
+// Content MD5 are returned with an empty value so they're re-uploaded.
+func blobDirectoryRemoteFiles(ctx context.Context, client shim.StorageContainerWrapper, containerName, prefix string) (map[string]string, error) {
+	files := make(map[string]string)
+
+	input := containers.ListBlobsInput{
+		MaxResults: pointer.To(5000),
+	}
+	if prefix != "" {
+		input.Prefix = pointer.To(prefix)
+	}
+
+	for {
+		result, err := client.ListBlobs(ctx, containerName, input)
+		if err != nil {
+			return nil, fmt.Errorf("listing Blobs: %+v", err)
+		}
+
+		for _, blob := range result.Blobs {
+			hash := ""
+			if blob.ContentMD5 != "" {
+				hash, err = convertBase64ToHexEncoding(blob.ContentMD5)
+				if err != nil {
+					return nil, fmt.Errorf("parsing Content MD5 for Blob %q: %+v", blob.Name, err)
+				}
+			}
+			files[strings.TrimPrefix(blob.Name, prefix)] = hash
+		}
+
+		if result.NextMarker == "" {
+			break
+		}
+		input.Marker = pointer.To(result.NextMarker)
+	}
+
+	return files, nil
+}
+
+type BlobDirectoryUpload struct {
+	Client *blobs.Client
+
+	ContainerName string
+	Prefix        string
+	Source        string
+
+	CacheControl        string
+	ContentTypes        map[string]string
+	DefaultContentType  string
+	Parallelism         int
+	ExpectedContentMD5s map[string]string
+}
+
+// Upload uploads the named files from the Source directory in parallel, verifying that the contents of each
+// file still match the MD5 calculated during the plan
+func (u BlobDirectoryUpload) Upload(ctx context.Context, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	queue := make(chan string, len(names))
+	for _, name := range names {
+		queue <- name
+	}
+	close(queue)
+
+	errors := make(chan error, len(names))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(names))
+
+	workerCount := u.Parallelism
+	if workerCount < 1 {
+		workerCount = 1
+	}
+	if workerCount > len(names) {
+		workerCount = len(names)
+	}
+	for i := 0; i < workerCount; i++ {
+		go func() {
+			for name := range queue {
+				if err := u.uploadFile(ctx, name); err != nil {
+					errors <- err
+				}
+				wg.Done()
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errors)
+
+	messages := make([]string, 0)
+	for err := range errors {
+		messages = append(messages, err.Error())
+	}
+	if len(messages) > 0 {
+		sort.Strings(messages)
+		return fmt.Errorf("uploading %d of %d files:\n%s", len(messages), len(names), strings.Join(messages, "\n"))
+	}
+
+	return nil
+}
+
+func (u BlobDirectoryUpload) uploadFile(ctx context.Context, name string) error {
+	source := filepath.Join(u.Source, filepath.FromSlash(name))
+
+	hash, err := blobDirectoryFileMD5(source)
+	if err != nil {
+		return fmt.Errorf("%q: %+v", name, err)
+	}
+	if expected, ok := u.ExpectedContentMD5s[name]; ok && expected != hash {
+		return fmt.Errorf("%q: the file has changed since the plan was created (expected an MD5 of %q but got %q)", name, expected, hash)
+	}
+
+	contentMD5, err := convertHexToBase64Encoding(hash)
+	if err != nil {
+		return fmt.Errorf("%q: %+v", name, err)
+	}
+
+	upload := BlobUpload{
+		Client:        u.Client,
+		BlobName:      u.Prefix + name,
+		ContainerName: u.ContainerName,
+
+		BlobType:     "Block",
+		CacheControl: u.CacheControl,
+		ContentType:  blobDirectoryContentType(name, u.ContentTypes, u.DefaultContentType),
+		ContentMD5:   contentMD5,
+		Source:       source,
+	}
+	if err := upload.Create(ctx); err != nil {
+		return fmt.Errorf("%q: %+v", name, err)
+	}
+
+	return nil
+}
+
+// blobDirectoryOrphanedBlobs returns the names of the remote Blobs which no longer correspond to a local file. Unless
+// `deleteOrphanedBlobs` is set only Blobs which were previously uploaded are returned, so that Blobs created outside
+// of Terraform are left alone
+func blobDirectoryOrphanedBlobs(remote, previous, desired map[string]string, deleteOrphanedBlobs bool) []string {
+	result := make([]string, 0)
+	for name := range remote {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		if _, ok := previous[name]; ok || deleteOrphanedBlobs {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// blobDirectoryDeleteBlobs deletes the named Blobs (and their snapshots) in parallel, ignoring any which no longer exist
+func blobDirectoryDeleteBlobs(ctx context.Context, client *blobs.Client, containerName, prefix string, names []string, parallelism int) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	queue := make(chan string, len(names))
+	for _, name := range names {
+		queue <- name
+	}
+	close(queue)
+
+	errors := make(chan error, len(names))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(names))
+
+	workerCount := parallelism
+	if workerCount < 1 {
+		workerCount = 1
+	}
+	if workerCount > len(names) {
+		workerCount = len(names)
+	}
+	for i := 0; i < workerCount; i++ {
+		go func() {
+			for name := range queue {
+				input := blobs.DeleteInput{
+					DeleteSnapshots: true,
+				}
+				if resp, err := client.Delete(ctx, containerName, prefix+name, input); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					errors <- fmt.Errorf("%q: %+v", prefix+name, err)
+				}
+				wg.Done()
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errors)
+
+	messages := make([]string, 0)
+	for err := range errors {
+		messages = append(messages, err.Error())
+	}
+	if len(messages) > 0 {
+		sort.Strings(messages)
+		return fmt.Errorf("deleting %d of %d blobs:\n%s", len(messages), len(names), strings.Join(messages, "\n"))
+	}
+
+	return nil
+}
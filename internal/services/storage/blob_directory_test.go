@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestBlobDirectoryContentType(t *testing.T) {
+	overrides := map[string]string{
+		"json":  "application/vnd.custom+json",
+		".data": "application/x-custom",
+	}
+
+	testData := []struct {
+		name     string
+		expected string
+	}{
+		{
+			name:     "index.html",
+			expected: "text/html; charset=utf-8",
+		},
+		{
+			name:     "assets/STYLE.CSS",
+			expected: "text/css; charset=utf-8",
+		},
+		{
+			name:     "config/settings.json",
+			expected: "application/vnd.custom+json",
+		},
+		{
+			name:     "blob.DATA",
+			expected: "application/x-custom",
+		},
+		{
+			name:     "LICENSE",
+			expected: "application/octet-stream",
+		},
+		{
+			name:     "archive.unknown",
+			expected: "application/octet-stream",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := blobDirectoryContentType(v.name, overrides, "application/octet-stream")
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestBlobDirectoryLocalFiles(t *testing.T) {
+	source := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(source, "assets", "img"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(source, "index.html"), []byte("hello world"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(source, "assets", "img", "empty.png"), []byte{}, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := blobDirectoryLocalFiles(source)
+	if err != nil {
+		t.Fatalf("reading local files: %+v", err)
+	}
+
+	expected := map[string]string{
+		"index.html":           "5eb63bbbe01eeed093cb22bb8f5acdc3",
+		"assets/img/empty.png": "d41d8cd98f00b204e9800998ecf8427e",
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d files but got %d: %+v", len(expected), len(actual), actual)
+	}
+	for k, v := range expected {
+		if actual[k] != v {
+			t.Fatalf("expected %q to have the MD5 %q but got %q", k, v, actual[k])
+		}
+	}
+
+	if _, err := blobDirectoryLocalFiles(filepath.Join(source, "missing")); err == nil {
+		t.Fatalf("expected an error for a missing directory but didn't get one")
+	}
+}
+
+func TestBlobDirectoryContentMD5(t *testing.T) {
+	files := map[string]string{
+		"a.txt": "5eb63bbbe01eeed093cb22bb8f5acdc3",
+		"b.txt": "d41d8cd98f00b204e9800998ecf8427e",
+	}
+
+	hash := blobDirectoryContentMD5(files)
+	if hash != blobDirectoryContentMD5(files) {
+		t.Fatalf("expected the hash to be stable for the same files")
+	}
+
+	renamed := map[string]string{
+		"a.txt": "5eb63bbbe01eeed093cb22bb8f5acdc3",
+		"c.txt": "d41d8cd98f00b204e9800998ecf8427e",
+	}
+	if hash == blobDirectoryContentMD5(renamed) {
+		t.Fatalf("expected the hash to change when a file is renamed")
+	}
+
+	modified := map[string]string{
+		"a.txt": "5eb63bbbe01eeed093cb22bb8f5acdc3",
+		"b.txt": "5eb63bbbe01eeed093cb22bb8f5acdc3",
+	}
+	if hash == blobDirectoryContentMD5(modified) {
+		t.Fatalf("expected the hash to change when a file is modified")
+	}
+}
+
+func TestBlobDirectoryOrphanedBlobs(t *testing.T) {
+	remote := map[string]string{
+		"a.txt":       "5eb63bbbe01eeed093cb22bb8f5acdc3",
+		"b.txt":       "d41d8cd98f00b204e9800998ecf8427e",
+		"foreign.txt": "d41d8cd98f00b204e9800998ecf8427e",
+	}
+	previous := map[string]string{
+		"a.txt": "5eb63bbbe01eeed093cb22bb8f5acdc3",
+		"b.txt": "d41d8cd98f00b204e9800998ecf8427e",
+	}
+	desired := map[string]string{
+		"a.txt": "5eb63bbbe01eeed093cb22bb8f5acdc3",
+	}
+
+	testData := []struct {
+		name                string
+		deleteOrphanedBlobs bool
+		expected            []string
+	}{
+		{
+			name:                "removed files only",
+			deleteOrphanedBlobs: false,
+			expected:            []string{"b.txt"},
+		},
+		{
+			name:                "every orphaned blob",
+			deleteOrphanedBlobs: true,
+			expected:            []string{"b.txt", "foreign.txt"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := blobDirectoryOrphanedBlobs(remote, previous, desired, v.deleteOrphanedBlobs)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
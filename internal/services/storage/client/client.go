@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2023-01-01/storagetasks"
 )
 
 // StorageDomainSuffix is used by validation functions
@@ -24,6 +25,8 @@ type Client struct {
 	StorageDomainSuffix string
 
 	ResourceManager *storage_v2023_01_01.Client
+	// TODO: import the Storage Actions Meta Client and use that
+	StorageTasksClient *storagetasks.StorageTasksClient
 	// TODO: import the Storage Sync Meta Client and use that
 	SyncCloudEndpointsClient   *cloudendpointresource.CloudEndpointResourceClient
 	SyncGroupsClient           *syncgroupresource.SyncGroupResourceClient
@@ -50,6 +53,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		return nil, fmt.Errorf("building ResourceManager clients: %+v", err)
 	}
 
+	storageTasksClient, err := storagetasks.NewStorageTasksClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building StorageTasks client: %+v", err)
+	}
+	o.Configure(storageTasksClient.Client, o.Authorizers.ResourceManager)
+
 	syncCloudEndpointsClient, err := cloudendpointresource.NewCloudEndpointResourceClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building CloudEndpoint client: %+v", err)
@@ -84,6 +93,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	// (which should fix #2977) when the storage clients have been moved in here
 	client := Client{
 		ResourceManager:            resourceManager,
+		StorageTasksClient:         storageTasksClient,
 		SyncCloudEndpointsClient:   syncCloudEndpointsClient,
 		SyncRegisteredServerClient: syncRegisteredServersClient,
 		SyncServerEndpointsClient:  syncServerEndpointClient,
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = StorageBlobDirectoryId{}
+
+// StorageBlobDirectoryId is used by azurerm_storage_blob_directory to identify the set of Blobs
+// within a Storage Container which are managed from a local directory.
+type StorageBlobDirectoryId struct {
+	StorageContainerId commonids.StorageContainerId
+	Prefix             string
+}
+
+func (id StorageBlobDirectoryId) String() string {
+	components := []string{
+		fmt.Sprintf("Storage Container %s", id.StorageContainerId.String()),
+		fmt.Sprintf("Prefix %q", id.Prefix),
+	}
+	return fmt.Sprintf("Storage Blob Directory %s", strings.Join(components, " / "))
+}
+
+func (id StorageBlobDirectoryId) ID() string {
+	return fmt.Sprintf("%s|%s", id.StorageContainerId.ID(), id.Prefix)
+}
+
+func NewStorageBlobDirectoryId(storageContainerId commonids.StorageContainerId, prefix string) StorageBlobDirectoryId {
+	return StorageBlobDirectoryId{
+		StorageContainerId: storageContainerId,
+		Prefix:             prefix,
+	}
+}
+
+func StorageBlobDirectoryID(input string) (*StorageBlobDirectoryId, error) {
+	// Blob names can contain a `|` but Container IDs can't, so only the first one is the separator
+	segments := strings.SplitN(input, "|", 2)
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format {storageContainerId}|{prefix} but got %q", input)
+	}
+
+	storageContainerId, err := commonids.ParseStorageContainerID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Storage Container ID for Storage Blob Directory %q: %+v", segments[0], err)
+	}
+
+	return &StorageBlobDirectoryId{
+		StorageContainerId: *storageContainerId,
+		Prefix:             segments[1],
+	}, nil
+}
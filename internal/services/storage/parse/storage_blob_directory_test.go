@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestStorageBlobDirectoryIDFormatter(t *testing.T) {
+	containerId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/blobServices/default/containers/container1"
+	actual, err := StorageBlobDirectoryID(containerId + "|site/")
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	expected := containerId + "|site/"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
+func TestStorageBlobDirectoryID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *StorageBlobDirectoryId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// container id without a prefix separator
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/blobServices/default/containers/container1",
+			Error: true,
+		},
+		{
+			// not a container id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1|site/",
+			Error: true,
+		},
+		{
+			// empty prefix
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/blobServices/default/containers/container1|",
+			Expected: &StorageBlobDirectoryId{
+				Prefix: "",
+			},
+		},
+		{
+			// prefix containing the separator
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/blobServices/default/containers/container1|site|v2/",
+			Expected: &StorageBlobDirectoryId{
+				Prefix: "site|v2/",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := StorageBlobDirectoryID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.StorageContainerId.ContainerName != "container1" {
+			t.Fatalf("Expected %q but got %q for ContainerName", "container1", actual.StorageContainerId.ContainerName)
+		}
+		if actual.Prefix != v.Expected.Prefix {
+			t.Fatalf("Expected %q but got %q for Prefix", v.Expected.Prefix, actual.Prefix)
+		}
+	}
+}
@@ -74,6 +74,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		storageBlobsDataSource{},
 		storageTableDataSource{},
 		storageTableEntitiesDataSource{},
 		storageContainersDataSource{},
@@ -85,7 +86,12 @@ func (r Registration) Resources() []sdk.Resource {
 		AccountQueuePropertiesResource{},
 		AccountStaticWebsiteResource{},
 		LocalUserResource{},
+		StorageAccountFailoverResource{},
+		StorageBlobDirectoryResource{},
+		StorageBlobRestoreResource{},
 		StorageContainerImmutabilityPolicyResource{},
+		StorageTaskAssignmentResource{},
+		StorageTaskResource{},
 		SyncServerEndpointResource{},
 	}
 }
@@ -0,0 +1,9 @@
+package storagetasks
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type IfCondition struct {
+	Condition  string                 `json:"condition"`
+	Operations []StorageTaskOperation `json:"operations"`
+}
@@ -0,0 +1,14 @@
+package storagetasks
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type StorageTaskUpdateParameters struct {
+	Identity   *identity.LegacySystemAndUserAssignedMap `json:"identity,omitempty"`
+	Properties *StorageTaskUpdateProperties             `json:"properties,omitempty"`
+	Tags       *map[string]string                       `json:"tags,omitempty"`
+}
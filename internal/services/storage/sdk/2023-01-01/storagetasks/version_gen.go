@@ -0,0 +1,14 @@
+package storagetasks
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const defaultApiVersion = "2023-01-01"
+
+func userAgent() string {
+	return "hashicorp/go-azure-sdk/storagetasks/2023-01-01"
+}
+
+func AzureAPIVersion() string {
+	return defaultApiVersion
+}
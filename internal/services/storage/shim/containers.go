@@ -14,6 +14,7 @@ type StorageContainerWrapper interface {
 	Delete(ctx context.Context, containerName string) error
 	Exists(ctx context.Context, containerName string) (*bool, error)
 	Get(ctx context.Context, containerName string) (*StorageContainerProperties, error)
+	ListBlobs(ctx context.Context, containerName string, input containers.ListBlobsInput) (*StorageContainerListBlobsResult, error)
 	UpdateAccessLevel(ctx context.Context, containerName string, level containers.AccessLevel) error
 	UpdateMetaData(ctx context.Context, containerName string, metaData map[string]string) error
 }
@@ -26,3 +27,27 @@ type StorageContainerProperties struct {
 	HasImmutabilityPolicy           bool
 	HasLegalHold                    bool
 }
+
+type StorageContainerListBlobsResult struct {
+	Blobs      []StorageContainerBlob
+	NextMarker string
+	Prefixes   []string
+}
+
+type StorageContainerBlob struct {
+	Name             string
+	Snapshot         string
+	VersionId        string
+	IsCurrentVersion bool
+	MetaData         map[string]string
+	Tags             map[string]string
+
+	AccessTier    string
+	BlobType      string
+	ContentLength int64
+	ContentMD5    string
+	ContentType   string
+	CreationTime  string
+	ETag          string
+	LastModified  string
+}
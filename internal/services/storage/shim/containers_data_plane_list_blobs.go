@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shim
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/containers"
+)
+
+// ListBlobs lists the Blobs using Giovanni, however Giovanni's ListBlobsResult doesn't contain the index tags or
+// version of each Blob, only holds a single BlobPrefix and doesn't decode the Metadata - so the response body
+// (which is retained after unmarshalling) is decoded again to pick these up.
+//
+// Index tags and versions can be requested using `containers.Dataset("tags")` and `containers.Dataset("versions")`.
+func (w DataPlaneStorageContainerWrapper) ListBlobs(ctx context.Context, containerName string, input containers.ListBlobsInput) (*StorageContainerListBlobsResult, error) {
+	resp, err := w.client.ListBlobs(ctx, containerName, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.HttpResponse == nil || resp.HttpResponse.Body == nil {
+		return nil, fmt.Errorf("listing Blobs: response body was nil")
+	}
+	defer resp.HttpResponse.Body.Close()
+
+	var result listBlobsEnumerationResults
+	if err := xml.NewDecoder(resp.HttpResponse.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decoding response: %+v", err)
+	}
+
+	return result.toResult(), nil
+}
+
+type listBlobsEnumerationResults struct {
+	XMLName    xml.Name `xml:"EnumerationResults"`
+	NextMarker string   `xml:"NextMarker"`
+	Blobs      struct {
+		Blobs    []listBlobsBlob   `xml:"Blob"`
+		Prefixes []listBlobsPrefix `xml:"BlobPrefix"`
+	} `xml:"Blobs"`
+}
+
+type listBlobsBlob struct {
+	Name             string            `xml:"Name"`
+	Snapshot         string            `xml:"Snapshot"`
+	VersionId        string            `xml:"VersionId"`
+	IsCurrentVersion bool              `xml:"IsCurrentVersion"`
+	MetaData         listBlobsMetaData `xml:"Metadata"`
+	Tags             struct {
+		TagSet []struct {
+			Key   string `xml:"Key"`
+			Value string `xml:"Value"`
+		} `xml:"TagSet>Tag"`
+	} `xml:"Tags"`
+	Properties struct {
+		AccessTier    string `xml:"AccessTier"`
+		BlobType      string `xml:"BlobType"`
+		ContentLength int64  `xml:"Content-Length"`
+		ContentMD5    string `xml:"Content-MD5"`
+		ContentType   string `xml:"Content-Type"`
+		CreationTime  string `xml:"Creation-Time"`
+		ETag          string `xml:"Etag"`
+		LastModified  string `xml:"Last-Modified"`
+	} `xml:"Properties"`
+}
+
+type listBlobsPrefix struct {
+	Name string `xml:"Name"`
+}
+
+// listBlobsMetaData decodes the Metadata element, where each child element is a key with the value as its text
+type listBlobsMetaData map[string]string
+
+func (m *listBlobsMetaData) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	out := make(map[string]string)
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			var value string
+			if err := d.DecodeElement(&value, &t); err != nil {
+				return err
+			}
+			out[t.Name.Local] = value
+		case xml.EndElement:
+			*m = out
+			return nil
+		}
+	}
+}
+
+func (r listBlobsEnumerationResults) toResult() *StorageContainerListBlobsResult {
+	result := StorageContainerListBlobsResult{
+		Blobs:      make([]StorageContainerBlob, 0, len(r.Blobs.Blobs)),
+		NextMarker: r.NextMarker,
+		Prefixes:   make([]string, 0, len(r.Blobs.Prefixes)),
+	}
+
+	for _, v := range r.Blobs.Blobs {
+		blob := StorageContainerBlob{
+			Name:             v.Name,
+			Snapshot:         v.Snapshot,
+			VersionId:        v.VersionId,
+			IsCurrentVersion: v.IsCurrentVersion,
+			MetaData:         v.MetaData,
+			Tags:             make(map[string]string),
+			AccessTier:       v.Properties.AccessTier,
+			BlobType:         v.Properties.BlobType,
+			ContentLength:    v.Properties.ContentLength,
+			ContentMD5:       v.Properties.ContentMD5,
+			ContentType:      v.Properties.ContentType,
+			CreationTime:     v.Properties.CreationTime,
+			ETag:             v.Properties.ETag,
+			LastModified:     v.Properties.LastModified,
+		}
+		if blob.MetaData == nil {
+			blob.MetaData = make(map[string]string)
+		}
+		for _, tag := range v.Tags.TagSet {
+			blob.Tags[tag.Key] = tag.Value
+		}
+
+		result.Blobs = append(result.Blobs, blob)
+	}
+
+	for _, v := range r.Blobs.Prefixes {
+		result.Prefixes = append(result.Prefixes, v.Name)
+	}
+
+	return &result
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shim
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestListBlobsEnumerationResults(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<EnumerationResults ServiceEndpoint="https://account.blob.core.windows.net/" ContainerName="artifacts">
+  <Prefix>releases/</Prefix>
+  <Delimiter>/</Delimiter>
+  <Blobs>
+    <Blob>
+      <Name>releases/app.zip</Name>
+      <VersionId>2024-01-01T00:00:00.0000000Z</VersionId>
+      <IsCurrentVersion>true</IsCurrentVersion>
+      <Properties>
+        <Creation-Time>Mon, 01 Jan 2024 00:00:00 GMT</Creation-Time>
+        <Last-Modified>Tue, 02 Jan 2024 00:00:00 GMT</Last-Modified>
+        <Etag>0x8DC0A</Etag>
+        <Content-Length>1024</Content-Length>
+        <Content-Type>application/zip</Content-Type>
+        <Content-MD5>XrY7u+Ae7tCTyyK7j1rNww==</Content-MD5>
+        <BlobType>BlockBlob</BlobType>
+        <AccessTier>Hot</AccessTier>
+      </Properties>
+      <Metadata>
+        <pipeline>build</pipeline>
+        <commit>abc123</commit>
+      </Metadata>
+      <Tags>
+        <TagSet>
+          <Tag><Key>environment</Key><Value>production</Value></Tag>
+        </TagSet>
+      </Tags>
+    </Blob>
+    <BlobPrefix><Name>releases/v1/</Name></BlobPrefix>
+    <Blob>
+      <Name>releases/app.zip</Name>
+      <Snapshot>2024-01-01T12:00:00.0000000Z</Snapshot>
+      <Properties>
+        <Content-Length>512</Content-Length>
+        <BlobType>BlockBlob</BlobType>
+      </Properties>
+      <Metadata />
+    </Blob>
+    <BlobPrefix><Name>releases/v2/</Name></BlobPrefix>
+  </Blobs>
+  <NextMarker>marker2</NextMarker>
+</EnumerationResults>`
+
+	var raw listBlobsEnumerationResults
+	if err := xml.Unmarshal([]byte(input), &raw); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+	actual := raw.toResult()
+
+	if actual.NextMarker != "marker2" {
+		t.Fatalf("expected the NextMarker to be %q but got %q", "marker2", actual.NextMarker)
+	}
+	if len(actual.Prefixes) != 2 || actual.Prefixes[0] != "releases/v1/" || actual.Prefixes[1] != "releases/v2/" {
+		t.Fatalf("expected two prefixes but got %+v", actual.Prefixes)
+	}
+	if len(actual.Blobs) != 2 {
+		t.Fatalf("expected two blobs but got %d", len(actual.Blobs))
+	}
+
+	current := actual.Blobs[0]
+	if !current.IsCurrentVersion || current.VersionId != "2024-01-01T00:00:00.0000000Z" {
+		t.Fatalf("expected the first blob to be the current version but got %+v", current)
+	}
+	if current.ContentLength != 1024 || current.ContentType != "application/zip" || current.AccessTier != "Hot" || current.BlobType != "BlockBlob" {
+		t.Fatalf("unexpected properties for the first blob: %+v", current)
+	}
+	if len(current.MetaData) != 2 || current.MetaData["pipeline"] != "build" || current.MetaData["commit"] != "abc123" {
+		t.Fatalf("unexpected metadata for the first blob: %+v", current.MetaData)
+	}
+	if len(current.Tags) != 1 || current.Tags["environment"] != "production" {
+		t.Fatalf("unexpected tags for the first blob: %+v", current.Tags)
+	}
+
+	snapshot := actual.Blobs[1]
+	if snapshot.Snapshot != "2024-01-01T12:00:00.0000000Z" {
+		t.Fatalf("expected the second blob to be a snapshot but got %+v", snapshot)
+	}
+	if len(snapshot.MetaData) != 0 || len(snapshot.Tags) != 0 {
+		t.Fatalf("expected no metadata or tags for the second blob but got %+v / %+v", snapshot.MetaData, snapshot.Tags)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shim
+
+import (
+	"context"
+)
+
+type StoragePathsWrapper interface {
+	ListPaths(ctx context.Context, fileSystemName string, input StoragePathListInput) (*StoragePathListResult, error)
+	SetAccessControlRecursive(ctx context.Context, fileSystemName, path string, input StoragePathSetAccessControlRecursiveInput) (*StoragePathSetAccessControlRecursiveResult, error)
+}
+
+type StoragePathListInput struct {
+	Directory    string
+	Recursive    bool
+	MaxResults   *int
+	Continuation *string
+}
+
+type StoragePathListResult struct {
+	Paths []StoragePath
+
+	// Continuation is returned when there are further Paths to list
+	Continuation string
+}
+
+type StoragePath struct {
+	Name        string
+	IsDirectory bool
+}
+
+type StoragePathSetAccessControlRecursiveInput struct {
+	Mode         string
+	ACL          string
+	Continuation *string
+	MaxRecords   *int
+	ForceFlag    bool
+}
+
+type StoragePathSetAccessControlRecursiveResult struct {
+	DirectoriesSuccessful int64
+	FilesSuccessful       int64
+	FailureCount          int64
+	FailedEntries         []StoragePathAccessControlFailure
+
+	// Continuation is returned when there are further Paths to process
+	Continuation string
+}
+
+type StoragePathAccessControlFailure struct {
+	ErrorMessage string
+	Name         string
+	Type         string
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/datalakestore/paths"
+)
+
+// Giovanni's Paths client doesn't support listing the Paths within a directory or setting Access Control
+// recursively, so these requests are built using the underlying client of the Paths client.
+
+type DataPlaneStoragePathsWrapper struct {
+	client *paths.Client
+}
+
+func NewDataPlaneStoragePathsWrapper(client *paths.Client) StoragePathsWrapper {
+	return DataPlaneStoragePathsWrapper{
+		client: client,
+	}
+}
+
+func (w DataPlaneStoragePathsWrapper) ListPaths(ctx context.Context, fileSystemName string, input StoragePathListInput) (*StoragePathListResult, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: listPathsOptions{input: input},
+		Path:          fmt.Sprintf("/%s", fileSystemName),
+	}
+
+	req, err := w.client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %+v", err)
+	}
+
+	var model listPathsResponse
+	if err := resp.Unmarshal(&model); err != nil {
+		return nil, fmt.Errorf("unmarshalling response: %+v", err)
+	}
+
+	result := StoragePathListResult{
+		Paths: make([]StoragePath, 0, len(model.Paths)),
+	}
+	for _, v := range model.Paths {
+		result.Paths = append(result.Paths, StoragePath{
+			Name:        v.Name,
+			IsDirectory: bool(v.IsDirectory),
+		})
+	}
+	if resp.Header != nil {
+		result.Continuation = resp.Header.Get("x-ms-continuation")
+	}
+
+	return &result, nil
+}
+
+func (w DataPlaneStoragePathsWrapper) SetAccessControlRecursive(ctx context.Context, fileSystemName, path string, input StoragePathSetAccessControlRecursiveInput) (*StoragePathSetAccessControlRecursiveResult, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPatch,
+		OptionsObject: setAccessControlRecursiveOptions{input: input},
+		Path:          fmt.Sprintf("/%s/%s", fileSystemName, path),
+	}
+
+	req, err := w.client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %+v", err)
+	}
+
+	var model setAccessControlRecursiveResponse
+	if err := resp.Unmarshal(&model); err != nil {
+		return nil, fmt.Errorf("unmarshalling response: %+v", err)
+	}
+
+	result := StoragePathSetAccessControlRecursiveResult{
+		DirectoriesSuccessful: model.DirectoriesSuccessful,
+		FilesSuccessful:       model.FilesSuccessful,
+		FailureCount:          model.FailureCount,
+		FailedEntries:         make([]StoragePathAccessControlFailure, 0, len(model.FailedEntries)),
+	}
+	for _, v := range model.FailedEntries {
+		result.FailedEntries = append(result.FailedEntries, StoragePathAccessControlFailure{
+			ErrorMessage: v.ErrorMessage,
+			Name:         v.Name,
+			Type:         v.Type,
+		})
+	}
+	if resp.Header != nil {
+		result.Continuation = resp.Header.Get("x-ms-continuation")
+	}
+
+	return &result, nil
+}
+
+type listPathsResponse struct {
+	Paths []struct {
+		Name        string           `json:"name"`
+		IsDirectory listPathsBoolean `json:"isDirectory"`
+	} `json:"paths"`
+}
+
+// listPathsBoolean handles boolean values which the List Paths API returns as strings
+type listPathsBoolean bool
+
+func (b *listPathsBoolean) UnmarshalJSON(input []byte) error {
+	*b = listPathsBoolean(strings.EqualFold(strings.Trim(string(input), `"`), "true"))
+	return nil
+}
+
+var _ client.Options = listPathsOptions{}
+
+type listPathsOptions struct {
+	input StoragePathListInput
+}
+
+func (o listPathsOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (o listPathsOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o listPathsOptions) ToQuery() *client.QueryParams {
+	query := &client.QueryParams{}
+	query.Append("resource", "filesystem")
+	query.Append("recursive", fmt.Sprintf("%t", o.input.Recursive))
+	if o.input.Directory != "" {
+		query.Append("directory", o.input.Directory)
+	}
+	if o.input.MaxResults != nil {
+		query.Append("maxResults", fmt.Sprintf("%d", *o.input.MaxResults))
+	}
+	if o.input.Continuation != nil {
+		query.Append("continuation", *o.input.Continuation)
+	}
+	return query
+}
+
+type setAccessControlRecursiveResponse struct {
+	DirectoriesSuccessful int64 `json:"directoriesSuccessful"`
+	FilesSuccessful       int64 `json:"filesSuccessful"`
+	FailureCount          int64 `json:"failureCount"`
+	FailedEntries         []struct {
+		ErrorMessage string `json:"errorMessage"`
+		Name         string `json:"name"`
+		Type         string `json:"type"`
+	} `json:"failedEntries"`
+}
+
+var _ client.Options = setAccessControlRecursiveOptions{}
+
+type setAccessControlRecursiveOptions struct {
+	input StoragePathSetAccessControlRecursiveInput
+}
+
+func (o setAccessControlRecursiveOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("x-ms-acl", o.input.ACL)
+	return headers
+}
+
+func (o setAccessControlRecursiveOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o setAccessControlRecursiveOptions) ToQuery() *client.QueryParams {
+	query := &client.QueryParams{}
+	query.Append("action", "setAccessControlRecursive")
+	query.Append("mode", o.input.Mode)
+	if o.input.Continuation != nil {
+		query.Append("continuation", *o.input.Continuation)
+	}
+	if o.input.MaxRecords != nil {
+		query.Append("maxRecords", fmt.Sprintf("%d", *o.input.MaxRecords))
+	}
+	if o.input.ForceFlag {
+		query.Append("forceFlag", "true")
+	}
+	return query
+}
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shim
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestListPathsResponse(t *testing.T) {
+	input := `{"paths":[{"name":"data/raw","isDirectory":"true"},{"name":"data/raw/file.csv","contentLength":"12"},{"name":"data/other","isDirectory":true}]}`
+
+	var result listPathsResponse
+	if err := json.Unmarshal([]byte(input), &result); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if len(result.Paths) != 3 {
+		t.Fatalf("expected 3 paths but got %d", len(result.Paths))
+	}
+	if !result.Paths[0].IsDirectory || result.Paths[1].IsDirectory || !result.Paths[2].IsDirectory {
+		t.Fatalf("unexpected directory flags: %+v", result.Paths)
+	}
+}
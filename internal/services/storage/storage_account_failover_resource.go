@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const storageAccountFailoverTypeUnplanned = "Unplanned"
+
+type StorageAccountFailoverResource struct{}
+
+var _ sdk.Resource = StorageAccountFailoverResource{}
+
+type StorageAccountFailoverResourceModel struct {
+	StorageAccountId  string            `tfschema:"storage_account_id"`
+	FailoverType      string            `tfschema:"failover_type"`
+	Triggers          map[string]string `tfschema:"triggers"`
+	LastSyncTime      string            `tfschema:"last_sync_time"`
+	PrimaryLocation   string            `tfschema:"primary_location"`
+	SecondaryLocation string            `tfschema:"secondary_location"`
+	SkuName           string            `tfschema:"sku_name"`
+	Status            string            `tfschema:"status"`
+}
+
+func (r StorageAccountFailoverResource) ResourceType() string {
+	return "azurerm_storage_account_failover"
+}
+
+func (r StorageAccountFailoverResource) ModelObject() interface{} {
+	return &StorageAccountFailoverResourceModel{}
+}
+
+func (r StorageAccountFailoverResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageAccountID
+}
+
+func (r StorageAccountFailoverResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		// there's intentionally no default, since an `Unplanned` failover can lose data and leaves the Storage Account locally redundant
+		"failover_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(storageaccounts.FailoverTypePlanned),
+				storageAccountFailoverTypeUnplanned,
+			}, false),
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r StorageAccountFailoverResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"last_sync_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"primary_location": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"secondary_location": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"sku_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageAccountFailoverResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// an unplanned failover typically completes within an hour, however a planned failover can take considerably longer
+		Timeout: 6 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageAccounts
+
+			var model StorageAccountFailoverResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			existing, err := client.GetProperties(ctx, *id, storageaccounts.GetPropertiesOperationOptions{
+				Expand: pointer.To(storageaccounts.StorageAccountExpandGeoReplicationStats),
+			})
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model` or `properties` was nil", *id)
+			}
+
+			stats := existing.Model.Properties.GeoReplicationStats
+			if stats == nil {
+				return fmt.Errorf("%s does not report any geo-replication statistics, failover is only supported for geo-redundant Storage Accounts", *id)
+			}
+
+			options := storageaccounts.DefaultFailoverOperationOptions()
+			if model.FailoverType == string(storageaccounts.FailoverTypePlanned) {
+				if !pointer.From(stats.CanPlannedFailover) {
+					return fmt.Errorf("a planned failover is not currently possible for %s (geo-replication status %q)", *id, pointer.FromEnum(stats.Status))
+				}
+				options.FailoverType = pointer.To(storageaccounts.FailoverTypePlanned)
+			} else if !pointer.From(stats.CanFailover) {
+				return fmt.Errorf("an unplanned failover is not currently possible for %s (geo-replication status %q)", *id, pointer.FromEnum(stats.Status))
+			}
+
+			// the last sync time is captured prior to failing over since any writes after this point are lost by an unplanned failover
+			model.LastSyncTime = pointer.From(stats.LastSyncTime)
+
+			log.Printf("[DEBUG] Starting %s failover of %s (last sync time %q)..", model.FailoverType, *id, model.LastSyncTime)
+			result, err := client.Failover(ctx, *id, options)
+			if err != nil {
+				return fmt.Errorf("failing over %s: %+v", *id, err)
+			}
+			if err := result.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for failover of %s: %+v", *id, err)
+			}
+			model.Status = string(result.Poller.LatestStatus())
+
+			resp, err := client.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s after failover: %+v", *id, err)
+			}
+			if resp.Model != nil {
+				if resp.Model.Sku != nil {
+					model.SkuName = string(resp.Model.Sku.Name)
+				}
+				if props := resp.Model.Properties; props != nil {
+					model.PrimaryLocation = location.NormalizeNilable(props.PrimaryLocation)
+					model.SecondaryLocation = location.NormalizeNilable(props.SecondaryLocation)
+				}
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageAccountFailoverResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageAccounts
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the failover is a point-in-time operation, so the result recorded at creation time is retained and
+			// only the existence of the Storage Account is checked here
+			resp, err := client.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountFailoverResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// a failover cannot be undone, so this only removes the resource from the state
+			return nil
+		},
+	}
+}
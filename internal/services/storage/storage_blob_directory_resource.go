@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageBlobDirectoryResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = StorageBlobDirectoryResource{}
+	_ sdk.ResourceWithCustomizeDiff = StorageBlobDirectoryResource{}
+)
+
+type StorageBlobDirectoryResourceModel struct {
+	StorageContainerId   string            `tfschema:"storage_container_id"`
+	SourceDirectory      string            `tfschema:"source_directory"`
+	Prefix               string            `tfschema:"prefix"`
+	CacheControl         string            `tfschema:"cache_control"`
+	ContentTypeOverrides map[string]string `tfschema:"content_type_overrides"`
+	DefaultContentType   string            `tfschema:"default_content_type"`
+	DeleteOrphanedBlobs  bool              `tfschema:"delete_orphaned_blobs"`
+	Parallelism          int64             `tfschema:"parallelism"`
+
+	ContentMD5 string            `tfschema:"content_md5"`
+	Files      map[string]string `tfschema:"files"`
+}
+
+// storageBlobDirectoryContentFields are the arguments which change the properties of every Blob, so all of the
+// files are uploaded again when any of them change
+var storageBlobDirectoryContentFields = []string{
+	"cache_control",
+	"content_type_overrides",
+	"default_content_type",
+}
+
+func (r StorageBlobDirectoryResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageContainerID,
+		},
+
+		"source_directory": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"prefix": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[^/].*/$`),
+				"`prefix` must end with a `/` and must not start with one",
+			),
+		},
+
+		"cache_control": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"content_type_overrides": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"default_content_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "application/octet-stream",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"delete_orphaned_blobs": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"parallelism": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      8,
+			ValidateFunc: validation.IntBetween(1, 64),
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"content_md5": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"files": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) ModelObject() interface{} {
+	return &StorageBlobDirectoryResourceModel{}
+}
+
+func (r StorageBlobDirectoryResource) ResourceType() string {
+	return "azurerm_storage_blob_directory"
+}
+
+func (r StorageBlobDirectoryResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageBlobDirectoryID
+}
+
+func (r StorageBlobDirectoryResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			// without a prefix every Blob in the Container would be treated as orphaned
+			if rd.Get("delete_orphaned_blobs").(bool) && rd.NewValueKnown("prefix") && rd.Get("prefix").(string) == "" {
+				return fmt.Errorf("`prefix` must be set when `delete_orphaned_blobs` is enabled")
+			}
+
+			if !rd.NewValueKnown("source_directory") {
+				if err := rd.SetNewComputed("files"); err != nil {
+					return err
+				}
+				return rd.SetNewComputed("content_md5")
+			}
+
+			// hashing the local files during the plan means that changes to the contents of the directory
+			// show up as a diff to `files`, which is compared against the Blobs in the Container during Read
+			files, err := blobDirectoryLocalFiles(rd.Get("source_directory").(string))
+			if err != nil {
+				return err
+			}
+
+			if err := rd.SetNew("files", files); err != nil {
+				return err
+			}
+			return rd.SetNew("content_md5", blobDirectoryContentMD5(files))
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config StorageBlobDirectoryResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			containerId, err := commonids.ParseStorageContainerID(config.StorageContainerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStorageBlobDirectoryId(*containerId, config.Prefix)
+
+			if err := r.sync(ctx, metadata, id, config, false); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageBlobDirectoryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state StorageBlobDirectoryResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId := commonids.NewStorageAccountID(id.StorageContainerId.SubscriptionId, id.StorageContainerId.ResourceGroupName, id.StorageContainerId.StorageAccountName)
+			account, err := storageClient.GetAccount(ctx, accountId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+			if account == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Containers Client: %+v", err)
+			}
+
+			exists, err := containersClient.Exists(ctx, id.StorageContainerId.ContainerName)
+			if err != nil {
+				return fmt.Errorf("checking for existence of %s: %+v", id.StorageContainerId, err)
+			}
+			if !pointer.From(exists) {
+				return metadata.MarkAsGone(id)
+			}
+
+			remote, err := blobDirectoryRemoteFiles(ctx, containersClient, id.StorageContainerId.ContainerName, id.Prefix)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// unless orphaned Blobs are being removed, other Blobs with the same prefix aren't managed by this resource
+			files := remote
+			if !state.DeleteOrphanedBlobs {
+				files = make(map[string]string)
+				for name := range state.Files {
+					if v, ok := remote[name]; ok {
+						files[name] = v
+					}
+				}
+			}
+
+			state.StorageContainerId = id.StorageContainerId.ID()
+			state.Prefix = id.Prefix
+			state.Files = files
+			state.ContentMD5 = blobDirectoryContentMD5(files)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageBlobDirectoryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config StorageBlobDirectoryResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			uploadAll := metadata.ResourceData.HasChanges(storageBlobDirectoryContentFields...)
+			if err := r.sync(ctx, metadata, *id, config, uploadAll); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageBlobDirectoryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state StorageBlobDirectoryResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId := commonids.NewStorageAccountID(id.StorageContainerId.SubscriptionId, id.StorageContainerId.ResourceGroupName, id.StorageContainerId.StorageAccountName)
+			account, err := storageClient.GetAccount(ctx, accountId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+			if account == nil {
+				return nil
+			}
+
+			blobsClient, err := storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Blobs Client: %+v", err)
+			}
+
+			names := make([]string, 0, len(state.Files))
+			for name := range state.Files {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			if err := blobDirectoryDeleteBlobs(ctx, blobsClient, id.StorageContainerId.ContainerName, id.Prefix, names, int(state.Parallelism)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// sync uploads any files which are missing or differ from the Blobs in the Container, and removes the Blobs for
+// any files which have been removed - or every orphaned Blob when `delete_orphaned_blobs` is enabled
+func (r StorageBlobDirectoryResource) sync(ctx context.Context, metadata sdk.ResourceMetaData, id parse.StorageBlobDirectoryId, config StorageBlobDirectoryResourceModel, uploadAll bool) error {
+	storageClient := metadata.Client.Storage
+
+	accountId := commonids.NewStorageAccountID(id.StorageContainerId.SubscriptionId, id.StorageContainerId.ResourceGroupName, id.StorageContainerId.StorageAccountName)
+	account, err := storageClient.GetAccount(ctx, accountId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+	if account == nil {
+		return fmt.Errorf("locating %s", accountId)
+	}
+
+	containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return fmt.Errorf("building Containers Client: %+v", err)
+	}
+
+	blobsClient, err := storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return fmt.Errorf("building Blobs Client: %+v", err)
+	}
+
+	remote, err := blobDirectoryRemoteFiles(ctx, containersClient, id.StorageContainerId.ContainerName, id.Prefix)
+	if err != nil {
+		return err
+	}
+
+	toUpload := make([]string, 0)
+	for name, hash := range config.Files {
+		if existing, ok := remote[name]; uploadAll || !ok || existing != hash {
+			toUpload = append(toUpload, name)
+		}
+	}
+	sort.Strings(toUpload)
+
+	upload := BlobDirectoryUpload{
+		Client:              blobsClient,
+		ContainerName:       id.StorageContainerId.ContainerName,
+		Prefix:              id.Prefix,
+		Source:              config.SourceDirectory,
+		CacheControl:        config.CacheControl,
+		ContentTypes:        config.ContentTypeOverrides,
+		DefaultContentType:  config.DefaultContentType,
+		Parallelism:         int(config.Parallelism),
+		ExpectedContentMD5s: config.Files,
+	}
+	if err := upload.Upload(ctx, toUpload); err != nil {
+		return err
+	}
+
+	// the files uploaded by a previous apply are in the prior state, those which have since been removed from
+	// `source_directory` are deleted regardless of `delete_orphaned_blobs`
+	previous := make(map[string]string)
+	if old, _ := metadata.ResourceData.GetChange("files"); old != nil {
+		for k, v := range old.(map[string]interface{}) {
+			previous[k] = v.(string)
+		}
+	}
+
+	toDelete := blobDirectoryOrphanedBlobs(remote, previous, config.Files, config.DeleteOrphanedBlobs)
+	if err := blobDirectoryDeleteBlobs(ctx, blobsClient, id.StorageContainerId.ContainerName, id.Prefix, toDelete, int(config.Parallelism)); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+type StorageBlobDirectoryResource struct{}
+
+func TestAccStorageBlobDirectory_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+	source := r.sourceDirectory(t, map[string]string{
+		"index.html":     "<html><body>hello world</body></html>",
+		"css/style.css":  "body { color: red; }",
+		"data/blob.json": `{"hello": "world"}`,
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+				check.That(data.ResourceName).Key("files.index.html").HasValue("ea3e2fe31e553fa351606244af0a89ed"),
+				check.That(data.ResourceName).Key("content_md5").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func TestAccStorageBlobDirectory_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+	source := r.sourceDirectory(t, map[string]string{
+		"index.html":    "<html><body>hello world</body></html>",
+		"css/style.css": "body { color: red; }",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("2"),
+			),
+		},
+		{
+			PreConfig: func() {
+				r.writeFile(t, source, "index.html", "<html><body>goodbye world</body></html>")
+				r.writeFile(t, source, "js/app.js", "console.log('hello world');")
+				if err := os.Remove(filepath.Join(source, "css", "style.css")); err != nil {
+					t.Fatalf("removing file: %+v", err)
+				}
+			},
+			Config: r.complete(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("2"),
+				check.That(data.ResourceName).Key("files.js/app.js").IsNotEmpty(),
+				data.CheckWithClient(r.blobDoesNotExist("site/css/style.css")),
+				data.CheckWithClient(r.blobHasContentType("site/js/app.js", "application/x-custom-js")),
+			),
+		},
+		{
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobHasContentType("site/js/app.js", "text/javascript; charset=utf-8")),
+			),
+		},
+	})
+}
+
+func (r StorageBlobDirectoryResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageBlobDirectoryID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	blobsClient, err := r.blobsClient(ctx, client, *id)
+	if err != nil {
+		return nil, err
+	}
+
+	for k := range state.Attributes {
+		if !strings.HasPrefix(k, "files.") || k == "files.%" {
+			continue
+		}
+
+		name := id.Prefix + strings.TrimPrefix(k, "files.")
+		resp, err := blobsClient.GetProperties(ctx, id.StorageContainerId.ContainerName, name, blobs.GetPropertiesInput{})
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, fmt.Errorf("retrieving Blob %q for %s: %+v", name, *id, err)
+		}
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r StorageBlobDirectoryResource) blobDoesNotExist(name string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := parse.StorageBlobDirectoryID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		blobsClient, err := r.blobsClient(ctx, client, *id)
+		if err != nil {
+			return err
+		}
+
+		resp, err := blobsClient.GetProperties(ctx, id.StorageContainerId.ContainerName, name, blobs.GetPropertiesInput{})
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return nil
+			}
+			return fmt.Errorf("retrieving Blob %q: %+v", name, err)
+		}
+
+		return fmt.Errorf("expected the Blob %q to have been deleted", name)
+	}
+}
+
+func (r StorageBlobDirectoryResource) blobHasContentType(name, contentType string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := parse.StorageBlobDirectoryID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		blobsClient, err := r.blobsClient(ctx, client, *id)
+		if err != nil {
+			return err
+		}
+
+		resp, err := blobsClient.GetProperties(ctx, id.StorageContainerId.ContainerName, name, blobs.GetPropertiesInput{})
+		if err != nil {
+			return fmt.Errorf("retrieving Blob %q: %+v", name, err)
+		}
+		if resp.ContentType != contentType {
+			return fmt.Errorf("expected the Blob %q to have the Content Type %q but got %q", name, contentType, resp.ContentType)
+		}
+
+		return nil
+	}
+}
+
+func (r StorageBlobDirectoryResource) blobsClient(ctx context.Context, client *clients.Client, id parse.StorageBlobDirectoryId) (*blobs.Client, error) {
+	accountId := commonids.NewStorageAccountID(id.StorageContainerId.SubscriptionId, id.StorageContainerId.ResourceGroupName, id.StorageContainerId.StorageAccountName)
+	account, err := client.Storage.GetAccount(ctx, accountId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate %s", accountId)
+	}
+
+	blobsClient, err := client.Storage.BlobsDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Blobs Client: %+v", err)
+	}
+
+	return blobsClient, nil
+}
+
+func (r StorageBlobDirectoryResource) sourceDirectory(t *testing.T, files map[string]string) string {
+	source := t.TempDir()
+	for name, content := range files {
+		r.writeFile(t, source, name, content)
+	}
+	return source
+}
+
+func (StorageBlobDirectoryResource) writeFile(t *testing.T, source, name, content string) {
+	path := filepath.Join(source, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("creating directory for %q: %+v", name, err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("writing %q: %+v", name, err)
+	}
+}
+
+func (r StorageBlobDirectoryResource) basic(data acceptance.TestData, source string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+  source_directory     = %q
+  prefix               = "site/"
+}
+`, r.template(data), filepath.ToSlash(source))
+}
+
+func (r StorageBlobDirectoryResource) complete(data acceptance.TestData, source string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory" "test" {
+  storage_container_id  = azurerm_storage_container.test.id
+  source_directory      = %q
+  prefix                = "site/"
+  cache_control         = "public, max-age=300"
+  default_content_type  = "text/plain"
+  delete_orphaned_blobs = true
+  parallelism           = 4
+
+  content_type_overrides = {
+    js = "application/x-custom-js"
+  }
+}
+`, r.template(data), filepath.ToSlash(source))
+}
+
+func (StorageBlobDirectoryResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "site"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
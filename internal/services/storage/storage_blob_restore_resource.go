@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageBlobRestoreResource struct{}
+
+var _ sdk.Resource = StorageBlobRestoreResource{}
+
+type StorageBlobRestoreResourceModel struct {
+	StorageAccountId string                         `tfschema:"storage_account_id"`
+	TimeToRestore    string                         `tfschema:"time_to_restore"`
+	BlobRange        []StorageBlobRestoreRangeModel `tfschema:"blob_range"`
+	Triggers         map[string]string              `tfschema:"triggers"`
+	RestoreId        string                         `tfschema:"restore_id"`
+	Status           string                         `tfschema:"status"`
+	FailureReason    string                         `tfschema:"failure_reason"`
+}
+
+type StorageBlobRestoreRangeModel struct {
+	StartRange string `tfschema:"start_range"`
+	EndRange   string `tfschema:"end_range"`
+}
+
+func (r StorageBlobRestoreResource) ResourceType() string {
+	return "azurerm_storage_blob_restore"
+}
+
+func (r StorageBlobRestoreResource) ModelObject() interface{} {
+	return &StorageBlobRestoreResourceModel{}
+}
+
+func (r StorageBlobRestoreResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageAccountID
+}
+
+func (r StorageBlobRestoreResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"time_to_restore": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"blob_range": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 10,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"start_range": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+
+					"end_range": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+				},
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r StorageBlobRestoreResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"restore_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"failure_reason": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageBlobRestoreResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 6 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageAccounts
+
+			var model StorageBlobRestoreResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			timeToRestore, err := time.Parse(time.RFC3339, model.TimeToRestore)
+			if err != nil {
+				return fmt.Errorf("parsing `time_to_restore`: %+v", err)
+			}
+			if !timeToRestore.Before(time.Now()) {
+				return fmt.Errorf("`time_to_restore` must be in the past but got %q", model.TimeToRestore)
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			payload := storageaccounts.BlobRestoreParameters{
+				BlobRanges: expandStorageBlobRestoreRanges(model.BlobRange),
+			}
+			payload.SetTimeToRestoreAsTime(timeToRestore)
+
+			log.Printf("[DEBUG] Restoring blob ranges for %s to %q..", *id, model.TimeToRestore)
+			result, err := client.RestoreBlobRanges(ctx, *id, payload)
+			if err != nil {
+				return fmt.Errorf("restoring blob ranges for %s: %+v", *id, err)
+			}
+
+			status := pointer.From(result.Model)
+			if err := result.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the blob range restore of %s: %+v", *id, err)
+			}
+
+			var final storageaccounts.BlobRestoreStatus
+			if err := result.Poller.FinalResult(&final); err != nil {
+				return fmt.Errorf("retrieving the result of the blob range restore of %s: %+v", *id, err)
+			}
+			if final.Status != nil {
+				status = final
+			}
+
+			model.RestoreId = pointer.From(status.RestoreId)
+			model.Status = pointer.FromEnum(status.Status)
+			model.FailureReason = pointer.From(status.FailureReason)
+
+			if pointer.From(status.Status) == storageaccounts.BlobRestoreProgressStatusFailed {
+				return fmt.Errorf("the blob range restore %q of %s failed: %s", model.RestoreId, *id, model.FailureReason)
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageBlobRestoreResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageAccounts
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the restore is a point-in-time operation, so the result recorded at creation time is retained and
+			// only the existence of the Storage Account is checked here
+			resp, err := client.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobRestoreResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// a restore cannot be undone, so this only removes the resource from the state
+			return nil
+		},
+	}
+}
+
+func expandStorageBlobRestoreRanges(input []StorageBlobRestoreRangeModel) []storageaccounts.BlobRestoreRange {
+	output := make([]storageaccounts.BlobRestoreRange, 0)
+	for _, v := range input {
+		output = append(output, storageaccounts.BlobRestoreRange{
+			StartRange: v.StartRange,
+			EndRange:   v.EndRange,
+		})
+	}
+	return output
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageBlobRestoreResource struct{}
+
+func TestAccStorageBlobRestore_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_restore", "test")
+	r := StorageBlobRestoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("restore_id").IsSet(),
+				check.That(data.ResourceName).Key("status").HasValue("Complete"),
+			),
+		},
+	})
+}
+
+func TestAccStorageBlobRestore_multipleRanges(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_restore", "test")
+	r := StorageBlobRestoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleRanges(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Complete"),
+			),
+		},
+	})
+}
+
+func (r StorageBlobRestoreResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseStorageAccountID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.ResourceManager.StorageAccounts.GetProperties(ctx, *id, storageaccounts.GetPropertiesOperationOptions{
+		Expand: pointer.To(storageaccounts.StorageAccountExpandBlobRestoreStatus),
+	})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.BlobRestoreStatus == nil {
+		return pointer.To(false), nil
+	}
+
+	return pointer.To(pointer.From(resp.Model.Properties.BlobRestoreStatus.RestoreId) == state.Attributes["restore_id"]), nil
+}
+
+func (r StorageBlobRestoreResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_restore" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  time_to_restore    = time_static.test.rfc3339
+
+  blob_range {
+    start_range = "${azurerm_storage_container.test.name}/"
+    end_range   = "${azurerm_storage_container.test.name}/zzzz"
+  }
+
+  depends_on = [time_sleep.test]
+}
+`, r.template(data))
+}
+
+func (r StorageBlobRestoreResource) multipleRanges(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_restore" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  time_to_restore    = time_static.test.rfc3339
+
+  blob_range {
+    start_range = "${azurerm_storage_container.test.name}/a"
+    end_range   = "${azurerm_storage_container.test.name}/m"
+  }
+
+  blob_range {
+    start_range = "${azurerm_storage_container.test.name}/n"
+  }
+
+  triggers = {
+    drill = "1"
+  }
+
+  depends_on = [time_sleep.test]
+}
+`, r.template(data))
+}
+
+func (r StorageBlobRestoreResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled  = true
+    change_feed_enabled = true
+
+    delete_retention_policy {
+      days = 7
+    }
+
+    restore_policy {
+      days = 6
+    }
+  }
+}
+
+resource "azurerm_storage_container" "test" {
+  name               = "restore"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "time_static" "test" {
+  depends_on = [azurerm_storage_container.test]
+}
+
+resource "time_sleep" "test" {
+  depends_on = [time_static.test]
+
+  create_duration = "5m"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/accounts"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/containers"
+)
+
+type storageBlobsDataSource struct{}
+
+var _ sdk.DataSource = storageBlobsDataSource{}
+
+type storageBlobsDataSourceModel struct {
+	StorageContainerId string      `tfschema:"storage_container_id"`
+	Prefix             string      `tfschema:"prefix"`
+	Delimiter          string      `tfschema:"delimiter"`
+	IncludeMetaData    bool        `tfschema:"include_metadata"`
+	IncludeSnapshots   bool        `tfschema:"include_snapshots"`
+	IncludeTags        bool        `tfschema:"include_tags"`
+	IncludeVersions    bool        `tfschema:"include_versions"`
+	Blobs              []blobModel `tfschema:"blobs"`
+	Prefixes           []string    `tfschema:"prefixes"`
+}
+
+type blobModel struct {
+	Name             string            `tfschema:"name"`
+	Url              string            `tfschema:"url"`
+	Type             string            `tfschema:"type"`
+	AccessTier       string            `tfschema:"access_tier"`
+	ContentMD5       string            `tfschema:"content_md5"`
+	ContentType      string            `tfschema:"content_type"`
+	Size             int64             `tfschema:"size"`
+	CreationTime     string            `tfschema:"creation_time"`
+	LastModified     string            `tfschema:"last_modified"`
+	ETag             string            `tfschema:"etag"`
+	MetaData         map[string]string `tfschema:"metadata"`
+	Tags             map[string]string `tfschema:"tags"`
+	Snapshot         string            `tfschema:"snapshot"`
+	VersionId        string            `tfschema:"version_id"`
+	IsCurrentVersion bool              `tfschema:"is_current_version"`
+}
+
+func (r storageBlobsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateStorageContainerID,
+		},
+
+		"prefix": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"delimiter": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"include_metadata": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"include_snapshots": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"include_tags": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"include_versions": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r storageBlobsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"blobs": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"url": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"access_tier": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"content_md5": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"content_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"size": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+					"creation_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"last_modified": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"etag": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"metadata": {
+						Type:     pluginsdk.TypeMap,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+					"tags": {
+						Type:     pluginsdk.TypeMap,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+					"snapshot": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"version_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"is_current_version": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"prefixes": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r storageBlobsDataSource) ResourceType() string {
+	return "azurerm_storage_blobs"
+}
+
+func (r storageBlobsDataSource) ModelObject() interface{} {
+	return &storageBlobsDataSourceModel{}
+}
+
+func (r storageBlobsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var plan storageBlobsDataSourceModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := commonids.ParseStorageContainerID(plan.StorageContainerId)
+			if err != nil {
+				return err
+			}
+
+			accountId := commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+			account, err := storageClient.GetAccount(ctx, accountId)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q: %v", id.StorageAccountName, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating Storage Account %q", id.StorageAccountName)
+			}
+
+			// Determine the blob endpoint, so we can build the URL for each Blob
+			endpoint, err := account.DataPlaneEndpoint(client.EndpointTypeBlob)
+			if err != nil {
+				return fmt.Errorf("determining Blob endpoint: %v", err)
+			}
+
+			dataPlaneAccountId, err := accounts.ParseAccountID(*endpoint, storageClient.StorageDomainSuffix)
+			if err != nil {
+				return fmt.Errorf("parsing Account ID: %v", err)
+			}
+
+			containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Containers Client: %v", err)
+			}
+
+			include := make([]containers.Dataset, 0)
+			if plan.IncludeMetaData {
+				include = append(include, containers.MetaData)
+			}
+			if plan.IncludeSnapshots {
+				include = append(include, containers.Snapshots)
+			}
+			if plan.IncludeTags {
+				include = append(include, containers.Dataset("tags"))
+			}
+			if plan.IncludeVersions {
+				include = append(include, containers.Dataset("versions"))
+			}
+
+			input := containers.ListBlobsInput{
+				MaxResults: pointer.To(5000),
+			}
+			if len(include) > 0 {
+				input.Include = pointer.To(include)
+			}
+			if plan.Prefix != "" {
+				input.Prefix = pointer.To(plan.Prefix)
+			}
+			if plan.Delimiter != "" {
+				input.Delimiter = pointer.To(plan.Delimiter)
+			}
+
+			plan.Blobs = make([]blobModel, 0)
+			plan.Prefixes = make([]string, 0)
+			for {
+				result, err := containersClient.ListBlobs(ctx, id.ContainerName, input)
+				if err != nil {
+					return fmt.Errorf("listing Blobs in %s: %+v", id, err)
+				}
+
+				page, err := flattenStorageBlobsBlobs(result.Blobs, *dataPlaneAccountId, id.ContainerName)
+				if err != nil {
+					return err
+				}
+				plan.Blobs = append(plan.Blobs, page...)
+				plan.Prefixes = append(plan.Prefixes, result.Prefixes...)
+
+				if result.NextMarker == "" {
+					break
+				}
+				input.Marker = pointer.To(result.NextMarker)
+			}
+
+			if err := metadata.Encode(&plan); err != nil {
+				return fmt.Errorf("encoding %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func flattenStorageBlobsBlobs(input []shim.StorageContainerBlob, accountId accounts.AccountId, containerName string) ([]blobModel, error) {
+	output := make([]blobModel, 0, len(input))
+	for _, item := range input {
+		contentMD5 := ""
+		if item.ContentMD5 != "" {
+			v, err := convertBase64ToHexEncoding(item.ContentMD5)
+			if err != nil {
+				return nil, fmt.Errorf("parsing Content MD5 for Blob %q: %+v", item.Name, err)
+			}
+			contentMD5 = v
+		}
+
+		output = append(output, blobModel{
+			Name:             item.Name,
+			Url:              blobs.NewBlobID(accountId, containerName, item.Name).ID(),
+			Type:             strings.TrimSuffix(item.BlobType, "Blob"),
+			AccessTier:       item.AccessTier,
+			ContentMD5:       contentMD5,
+			ContentType:      item.ContentType,
+			Size:             item.ContentLength,
+			CreationTime:     formatStorageBlobsTime(item.CreationTime),
+			LastModified:     formatStorageBlobsTime(item.LastModified),
+			ETag:             item.ETag,
+			MetaData:         item.MetaData,
+			Tags:             item.Tags,
+			Snapshot:         item.Snapshot,
+			VersionId:        item.VersionId,
+			IsCurrentVersion: item.IsCurrentVersion,
+		})
+	}
+
+	return output, nil
+}
+
+// formatStorageBlobsTime converts the RFC1123 timestamps returned by the Data Plane API to RFC3339
+func formatStorageBlobsTime(input string) string {
+	t, err := time.Parse(time.RFC1123, input)
+	if err != nil {
+		return input
+	}
+	return t.UTC().Format(time.RFC3339)
+}
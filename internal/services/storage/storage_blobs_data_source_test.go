@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type storageBlobsDataSource struct{}
+
+func TestAccDataSourceStorageBlobs_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blobs", "test")
+	d := storageBlobsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blobs.#").HasValue("3"),
+				check.That(data.ResourceName).Key("blobs.0.name").HasValue("config.json"),
+				check.That(data.ResourceName).Key("blobs.0.url").HasValue(
+					fmt.Sprintf("https://acctestacc%s.blob.core.windows.net/artifacts/config.json", data.RandomString),
+				),
+				check.That(data.ResourceName).Key("blobs.0.type").HasValue("Block"),
+				check.That(data.ResourceName).Key("blobs.0.content_type").HasValue("application/json"),
+				check.That(data.ResourceName).Key("blobs.0.content_md5").HasValue("fbc24bcc7a1794758fc1327fcfebdaf6"),
+				check.That(data.ResourceName).Key("blobs.0.size").HasValue("17"),
+				check.That(data.ResourceName).Key("blobs.0.metadata.%").HasValue("0"),
+				check.That(data.ResourceName).Key("prefixes.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageBlobs_prefixAndDelimiter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blobs", "test")
+	d := storageBlobsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.prefixAndDelimiter(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blobs.#").HasValue("1"),
+				check.That(data.ResourceName).Key("blobs.0.name").HasValue("releases/latest.txt"),
+				check.That(data.ResourceName).Key("blobs.0.metadata.%").HasValue("1"),
+				check.That(data.ResourceName).Key("blobs.0.metadata.pipeline").HasValue("build"),
+				check.That(data.ResourceName).Key("prefixes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("prefixes.0").HasValue("releases/v1/"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageBlobs_versions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blobs", "test")
+	d := storageBlobsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.versions(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blobs.0.name").HasValue("config.json"),
+				check.That(data.ResourceName).Key("blobs.0.version_id").IsNotEmpty(),
+				check.That(data.ResourceName).Key("blobs.0.is_current_version").HasValue("true"),
+			),
+		},
+	})
+}
+
+func (d storageBlobsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blobs" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+
+  depends_on = [
+    azurerm_storage_blob.config,
+    azurerm_storage_blob.latest,
+    azurerm_storage_blob.release,
+  ]
+}
+`, d.template(data, false))
+}
+
+func (d storageBlobsDataSource) prefixAndDelimiter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blobs" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+  prefix               = "releases/"
+  delimiter            = "/"
+  include_metadata     = true
+  include_tags         = true
+
+  depends_on = [
+    azurerm_storage_blob.config,
+    azurerm_storage_blob.latest,
+    azurerm_storage_blob.release,
+  ]
+}
+`, d.template(data, false))
+}
+
+func (d storageBlobsDataSource) versions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blobs" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+  include_versions     = true
+  include_snapshots    = true
+
+  depends_on = [
+    azurerm_storage_blob.config,
+    azurerm_storage_blob.latest,
+    azurerm_storage_blob.release,
+  ]
+}
+`, d.template(data, true))
+}
+
+func (storageBlobsDataSource) template(data acceptance.TestData, versioningEnabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled = %[4]t
+  }
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "artifacts"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "config" {
+  name                   = "config.json"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  content_type           = "application/json"
+  source_content         = "{\"hello\":\"world\"}"
+}
+
+resource "azurerm_storage_blob" "latest" {
+  name                   = "releases/latest.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "v1"
+
+  metadata = {
+    pipeline = "build"
+  }
+}
+
+resource "azurerm_storage_blob" "release" {
+  name                   = "releases/v1/app.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "hello world"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, versioningEnabled)
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/datalakestore/paths"
+	"github.com/jackofallops/giovanni/storage/accesscontrol"
+)
+
+const (
+	dataLakeGen2RecursiveAclModeSet    = "set"
+	dataLakeGen2RecursiveAclModeModify = "modify"
+
+	dataLakeGen2RecursiveAclDriftDetectionNone   = "none"
+	dataLakeGen2RecursiveAclDriftDetectionSample = "sample"
+	dataLakeGen2RecursiveAclDriftDetectionFull   = "full"
+
+	// dataLakeGen2RecursiveAclMaxDriftedPaths caps the number of drifted Paths which are stored in the state
+	dataLakeGen2RecursiveAclMaxDriftedPaths = 100
+
+	dataLakeGen2RecursiveAclDriftWorkers = 10
+)
+
+// applyDataLakeGen2RecursiveAcl applies the ACL to the directory and all of its children, following the continuation
+// token until every Path has been processed. When `continueOnFailure` is set the service continues past Paths which
+// can't be updated, these are collected and returned as a single error once all batches have been processed.
+func applyDataLakeGen2RecursiveAcl(ctx context.Context, c *paths.Client, fileSystemName, path, mode string, acl accesscontrol.ACL, batchSize int, continueOnFailure bool) error {
+	wrapper := shim.NewDataPlaneStoragePathsWrapper(c)
+	input := shim.StoragePathSetAccessControlRecursiveInput{
+		Mode:       mode,
+		ACL:        acl.String(),
+		MaxRecords: &batchSize,
+		ForceFlag:  continueOnFailure,
+	}
+
+	var directories, files, failureCount int64
+	failures := make([]shim.StoragePathAccessControlFailure, 0)
+	for {
+		result, err := wrapper.SetAccessControlRecursive(ctx, fileSystemName, path, input)
+		if err != nil {
+			return fmt.Errorf("setting access control recursively for Path %q in File System %q: %+v", path, fileSystemName, err)
+		}
+
+		directories += result.DirectoriesSuccessful
+		files += result.FilesSuccessful
+		failureCount += result.FailureCount
+		failures = append(failures, result.FailedEntries...)
+
+		if result.Continuation == "" {
+			break
+		}
+		input.Continuation = &result.Continuation
+	}
+
+	log.Printf("[DEBUG] Access Control set recursively for Path %q in File System %q: %d directories, %d files, %d failures", path, fileSystemName, directories, files, failureCount)
+
+	if failureCount > 0 {
+		return fmt.Errorf("setting access control recursively for Path %q in File System %q failed for %d Paths: %s", path, fileSystemName, failureCount, formatDataLakeGen2AccessControlFailures(failures))
+	}
+
+	return nil
+}
+
+func formatDataLakeGen2AccessControlFailures(input []shim.StoragePathAccessControlFailure) string {
+	const maxEntries = 10
+
+	entries := make([]string, 0)
+	for i, v := range input {
+		if i == maxEntries {
+			entries = append(entries, fmt.Sprintf("(and %d more)", len(input)-maxEntries))
+			break
+		}
+		entries = append(entries, fmt.Sprintf("%q: %s", v.Name, v.ErrorMessage))
+	}
+	return strings.Join(entries, ", ")
+}
+
+// findDataLakeGen2RecursiveAclDrift checks the ACLs of the children of the directory against the expected ACL,
+// returning the (sorted) names of the Paths which have drifted. When `sampleSize` is greater than zero only the
+// first `sampleSize` children are checked, otherwise every child is checked.
+func findDataLakeGen2RecursiveAclDrift(ctx context.Context, c *paths.Client, fileSystemName, path, mode string, expected accesscontrol.ACL, sampleSize int) ([]string, error) {
+	wrapper := shim.NewDataPlaneStoragePathsWrapper(c)
+	children := make([]shim.StoragePath, 0)
+	input := shim.StoragePathListInput{
+		Directory: path,
+		Recursive: true,
+	}
+	if sampleSize > 0 {
+		input.MaxResults = &sampleSize
+	}
+	for {
+		result, err := wrapper.ListPaths(ctx, fileSystemName, input)
+		if err != nil {
+			return nil, fmt.Errorf("listing Paths within %q in File System %q: %+v", path, fileSystemName, err)
+		}
+		children = append(children, result.Paths...)
+
+		if result.Continuation == "" || (sampleSize > 0 && len(children) >= sampleSize) {
+			break
+		}
+		input.Continuation = &result.Continuation
+	}
+	if sampleSize > 0 && len(children) > sampleSize {
+		children = children[:sampleSize]
+	}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var errs []error
+	drifted := make([]string, 0)
+
+	queue := make(chan shim.StoragePath)
+	for i := 0; i < dataLakeGen2RecursiveAclDriftWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for child := range queue {
+				resp, err := c.GetProperties(ctx, fileSystemName, child.Name, paths.GetPropertiesInput{Action: paths.GetPropertiesActionGetAccessControl})
+				if err == nil {
+					var actual accesscontrol.ACL
+					actual, err = accesscontrol.ParseACL(resp.ACL)
+					if err == nil && dataLakeGen2AclDrifted(expected, actual, child.IsDirectory, mode) {
+						mutex.Lock()
+						drifted = append(drifted, child.Name)
+						mutex.Unlock()
+					}
+				}
+				if err != nil {
+					mutex.Lock()
+					errs = append(errs, fmt.Errorf("retrieving ACLs for Path %q: %+v", child.Name, err))
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+	for _, child := range children {
+		queue <- child
+	}
+	close(queue)
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	sort.Strings(drifted)
+	if len(drifted) > dataLakeGen2RecursiveAclMaxDriftedPaths {
+		drifted = drifted[:dataLakeGen2RecursiveAclMaxDriftedPaths]
+	}
+
+	return drifted, nil
+}
+
+// dataLakeGen2AclDrifted determines whether the ACL of a child Path differs from the ACL applied recursively.
+// Files can't have a default scope, so these entries are ignored for files. In `set` mode the child must also not
+// contain any additional named user or group entries, whereas in `modify` mode these are left alone.
+func dataLakeGen2AclDrifted(expected, actual accesscontrol.ACL, isDirectory bool, mode string) bool {
+	wanted := make([]accesscontrol.ACE, 0)
+	for _, v := range expected.Entries {
+		if v.IsDefault && !isDirectory {
+			continue
+		}
+		wanted = append(wanted, v)
+	}
+
+	for _, want := range wanted {
+		found := false
+		for _, got := range actual.Entries {
+			if dataLakeGen2AceMatches(want, got) {
+				found = got.Permissions == want.Permissions
+				break
+			}
+		}
+		if !found {
+			return true
+		}
+	}
+
+	if mode == dataLakeGen2RecursiveAclModeSet {
+		for _, got := range actual.Entries {
+			if got.TagQualifier == nil {
+				continue
+			}
+			found := false
+			for _, want := range wanted {
+				if dataLakeGen2AceMatches(want, got) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func dataLakeGen2AceMatches(first, second accesscontrol.ACE) bool {
+	if first.IsDefault != second.IsDefault || first.TagType != second.TagType {
+		return false
+	}
+	if first.TagQualifier == nil || second.TagQualifier == nil {
+		return first.TagQualifier == nil && second.TagQualifier == nil
+	}
+	return *first.TagQualifier == *second.TagQualifier
+}
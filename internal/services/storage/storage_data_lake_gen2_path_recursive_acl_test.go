@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/jackofallops/giovanni/storage/accesscontrol"
+)
+
+func TestDataLakeGen2AclDrifted(t *testing.T) {
+	parse := func(input string) accesscontrol.ACL {
+		acl, err := accesscontrol.ParseACL(input)
+		if err != nil {
+			t.Fatalf("parsing ACL %q: %+v", input, err)
+		}
+		return acl
+	}
+
+	expected := parse("user::rwx,user:00000000-0000-0000-0000-000000000001:r-x,group::r-x,mask::r-x,other::---,default:user:00000000-0000-0000-0000-000000000001:r-x")
+
+	testData := []struct {
+		Name        string
+		Actual      string
+		IsDirectory bool
+		Mode        string
+		Drifted     bool
+	}{
+		{
+			Name:        "directory matches",
+			Actual:      "user::rwx,user:00000000-0000-0000-0000-000000000001:r-x,group::r-x,mask::r-x,other::---,default:user:00000000-0000-0000-0000-000000000001:r-x",
+			IsDirectory: true,
+			Mode:        dataLakeGen2RecursiveAclModeSet,
+			Drifted:     false,
+		},
+		{
+			Name:        "directory missing default entry",
+			Actual:      "user::rwx,user:00000000-0000-0000-0000-000000000001:r-x,group::r-x,mask::r-x,other::---",
+			IsDirectory: true,
+			Mode:        dataLakeGen2RecursiveAclModeSet,
+			Drifted:     true,
+		},
+		{
+			Name:        "file ignores default entries",
+			Actual:      "user::rwx,user:00000000-0000-0000-0000-000000000001:r-x,group::r-x,mask::r-x,other::---",
+			IsDirectory: false,
+			Mode:        dataLakeGen2RecursiveAclModeSet,
+			Drifted:     false,
+		},
+		{
+			Name:        "file with different permissions",
+			Actual:      "user::rwx,user:00000000-0000-0000-0000-000000000001:rwx,group::r-x,mask::rwx,other::---",
+			IsDirectory: false,
+			Mode:        dataLakeGen2RecursiveAclModeSet,
+			Drifted:     true,
+		},
+		{
+			Name:        "additional named entry in set mode",
+			Actual:      "user::rwx,user:00000000-0000-0000-0000-000000000001:r-x,user:00000000-0000-0000-0000-000000000002:r--,group::r-x,mask::r-x,other::---",
+			IsDirectory: false,
+			Mode:        dataLakeGen2RecursiveAclModeSet,
+			Drifted:     true,
+		},
+		{
+			Name:        "additional named entry in modify mode",
+			Actual:      "user::rwx,user:00000000-0000-0000-0000-000000000001:r-x,user:00000000-0000-0000-0000-000000000002:r--,group::r-x,mask::r-x,other::---",
+			IsDirectory: false,
+			Mode:        dataLakeGen2RecursiveAclModeModify,
+			Drifted:     false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := dataLakeGen2AclDrifted(expected, parse(v.Actual), v.IsDirectory, v.Mode)
+		if actual != v.Drifted {
+			t.Fatalf("expected drifted to be %t but got %t", v.Drifted, actual)
+		}
+	}
+}
@@ -78,6 +78,14 @@ func resourceStorageDataLakeGen2Path() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+			// when drift has been detected on the children of this Path, re-apply the ACL recursively
+			if d.Id() != "" && len(d.Get("recursive_acl").([]interface{})) > 0 && len(d.Get("recursive_acl_drifted_paths").([]interface{})) > 0 {
+				return d.SetNewComputed("recursive_acl_drifted_paths")
+			}
+			return nil
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"storage_account_id": {
 				Type:         pluginsdk.TypeString,
@@ -150,6 +158,66 @@ func resourceStorageDataLakeGen2Path() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"recursive_acl": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"ace"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  dataLakeGen2RecursiveAclModeSet,
+							ValidateFunc: validation.StringInSlice([]string{
+								dataLakeGen2RecursiveAclModeSet,
+								dataLakeGen2RecursiveAclModeModify,
+							}, false),
+						},
+
+						// drift detection is performed on every refresh, making a Get Properties request for each child which is checked
+						"drift_detection": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  dataLakeGen2RecursiveAclDriftDetectionSample,
+							ValidateFunc: validation.StringInSlice([]string{
+								dataLakeGen2RecursiveAclDriftDetectionNone,
+								dataLakeGen2RecursiveAclDriftDetectionSample,
+								dataLakeGen2RecursiveAclDriftDetectionFull,
+							}, false),
+						},
+
+						"sample_size": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntBetween(1, 5000),
+						},
+
+						"batch_size": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      2000,
+							ValidateFunc: validation.IntBetween(1, 2000),
+						},
+
+						"continue_on_failure": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"recursive_acl_drifted_paths": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -258,6 +326,12 @@ func resourceStorageDataLakeGen2PathCreate(d *pluginsdk.ResourceData, meta inter
 		}
 	}
 
+	if recursiveAcl := expandDataLakeGen2PathRecursiveAcl(d.Get("recursive_acl").([]interface{})); recursiveAcl != nil && acl != nil {
+		if err := applyDataLakeGen2RecursiveAcl(ctx, dataPlanePathsClient, filesystemName, path, recursiveAcl.Mode, *acl, recursiveAcl.BatchSize, recursiveAcl.ContinueOnFailure); err != nil {
+			return fmt.Errorf("applying ACL recursively for %s: %+v", id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceStorageDataLakeGen2PathRead(d, meta)
@@ -324,6 +398,16 @@ func resourceStorageDataLakeGen2PathUpdate(d *pluginsdk.ResourceData, meta inter
 		}
 	}
 
+	// the ACL is only re-applied to the children when the ACL or recursive settings change, or drift has been
+	// detected during the Read (which is surfaced as a change to `recursive_acl_drifted_paths` by the CustomizeDiff)
+	if d.HasChanges("ace", "recursive_acl", "recursive_acl_drifted_paths") {
+		if recursiveAcl := expandDataLakeGen2PathRecursiveAcl(d.Get("recursive_acl").([]interface{})); recursiveAcl != nil && acl != nil {
+			if err := applyDataLakeGen2RecursiveAcl(ctx, dataPlanePathsClient, id.FileSystemName, path, recursiveAcl.Mode, *acl, recursiveAcl.BatchSize, recursiveAcl.ContinueOnFailure); err != nil {
+				return fmt.Errorf("applying ACL recursively for %s: %+v", id, err)
+			}
+		}
+	}
+
 	return resourceStorageDataLakeGen2PathRead(d, meta)
 }
 
@@ -388,6 +472,25 @@ func resourceStorageDataLakeGen2PathRead(d *pluginsdk.ResourceData, meta interfa
 	}
 	d.Set("ace", FlattenDataLakeGen2AceList(d, acl))
 
+	driftedPaths := make([]string, 0)
+	if recursiveAcl := expandDataLakeGen2PathRecursiveAcl(d.Get("recursive_acl").([]interface{})); recursiveAcl != nil && recursiveAcl.DriftDetection != dataLakeGen2RecursiveAclDriftDetectionNone {
+		expected, err := ExpandDataLakeGen2AceList(d.Get("ace").(*pluginsdk.Set).List())
+		if err != nil {
+			return fmt.Errorf("parsing ace list: %v", err)
+		}
+		if expected != nil {
+			sampleSize := 0
+			if recursiveAcl.DriftDetection == dataLakeGen2RecursiveAclDriftDetectionSample {
+				sampleSize = recursiveAcl.SampleSize
+			}
+			driftedPaths, err = findDataLakeGen2RecursiveAclDrift(ctx, dataPlanePathsClient, id.FileSystemName, id.Path, recursiveAcl.Mode, *expected, sampleSize)
+			if err != nil {
+				return fmt.Errorf("checking the ACLs of the children of %s: %+v", id, err)
+			}
+		}
+	}
+	d.Set("recursive_acl_drifted_paths", driftedPaths)
+
 	return nil
 }
 
@@ -426,3 +529,26 @@ func resourceStorageDataLakeGen2PathDelete(d *pluginsdk.ResourceData, meta inter
 
 	return nil
 }
+
+type dataLakeGen2PathRecursiveAcl struct {
+	Mode              string
+	DriftDetection    string
+	SampleSize        int
+	BatchSize         int
+	ContinueOnFailure bool
+}
+
+func expandDataLakeGen2PathRecursiveAcl(input []interface{}) *dataLakeGen2PathRecursiveAcl {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &dataLakeGen2PathRecursiveAcl{
+		Mode:              v["mode"].(string),
+		DriftDetection:    v["drift_detection"].(string),
+		SampleSize:        v["sample_size"].(int),
+		BatchSize:         v["batch_size"].(int),
+		ContinueOnFailure: v["continue_on_failure"].(bool),
+	}
+}
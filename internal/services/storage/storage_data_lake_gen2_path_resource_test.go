@@ -131,6 +131,38 @@ func TestAccStorageDataLakeGen2Path_withSuperUsers(t *testing.T) {
 	})
 }
 
+func TestAccStorageDataLakeGen2Path_recursiveACL(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path", "test")
+	r := StorageDataLakeGen2PathResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.recursiveACL(data, "set", "r-x"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("recursive_acl", "recursive_acl_drifted_paths"),
+		{
+			// the child now exists, so updating the ACL should apply it to the child too
+			Config: r.recursiveACL(data, "set", "rwx"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("recursive_acl_drifted_paths.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("recursive_acl", "recursive_acl_drifted_paths"),
+		{
+			Config: r.recursiveACL(data, "modify", "r-x"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("recursive_acl_drifted_paths.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("recursive_acl", "recursive_acl_drifted_paths"),
+	})
+}
+
 func (r StorageDataLakeGen2PathResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := paths.ParsePathID(state.ID, client.Storage.StorageDomainSuffix)
 	if err != nil {
@@ -324,6 +356,69 @@ resource "azurerm_storage_data_lake_gen2_path" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r StorageDataLakeGen2PathResource) recursiveACL(data acceptance.TestData, mode, permissions string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctestspa%[2]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "testpath"
+  resource           = "directory"
+  ace {
+    type        = "user"
+    permissions = "rwx"
+  }
+  ace {
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "%[4]s"
+  }
+  ace {
+    type        = "group"
+    permissions = "r-x"
+  }
+  ace {
+    type        = "mask"
+    permissions = "rwx"
+  }
+  ace {
+    type        = "other"
+    permissions = "---"
+  }
+  ace {
+    scope       = "default"
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "%[4]s"
+  }
+
+  recursive_acl {
+    mode            = "%[3]s"
+    drift_detection = "full"
+  }
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "child" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "${azurerm_storage_data_lake_gen2_path.test.path}/child"
+  resource           = "directory"
+}
+`, template, data.RandomInteger, mode, permissions)
+}
+
 func (r StorageDataLakeGen2PathResource) withOwner(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,493 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/storagetaskassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2023-01-01/storagetasks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageTaskAssignmentResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = StorageTaskAssignmentResource{}
+	_ sdk.ResourceWithCustomizeDiff = StorageTaskAssignmentResource{}
+)
+
+type StorageTaskAssignmentResourceModel struct {
+	Name                  string                                `tfschema:"name"`
+	StorageAccountId      string                                `tfschema:"storage_account_id"`
+	StorageTaskId         string                                `tfschema:"storage_task_id"`
+	Description           string                                `tfschema:"description"`
+	Enabled               bool                                  `tfschema:"enabled"`
+	ExecutionTrigger      []StorageTaskAssignmentTriggerModel   `tfschema:"execution_trigger"`
+	ReportPrefix          string                                `tfschema:"report_prefix"`
+	TargetPrefixes        []string                              `tfschema:"target_prefixes"`
+	TargetExcludePrefixes []string                              `tfschema:"target_exclude_prefixes"`
+	RunStatus             []StorageTaskAssignmentRunStatusModel `tfschema:"run_status"`
+}
+
+type StorageTaskAssignmentTriggerModel struct {
+	Type           string `tfschema:"type"`
+	StartOn        string `tfschema:"start_on"`
+	StartFrom      string `tfschema:"start_from"`
+	IntervalInDays int64  `tfschema:"interval_in_days"`
+	EndBy          string `tfschema:"end_by"`
+}
+
+type StorageTaskAssignmentRunStatusModel struct {
+	StartTime         string `tfschema:"start_time"`
+	FinishTime        string `tfschema:"finish_time"`
+	RunResult         string `tfschema:"run_result"`
+	ObjectsTargeted   string `tfschema:"objects_targeted_count"`
+	ObjectsSucceeded  string `tfschema:"objects_succeeded_count"`
+	ObjectsFailed     string `tfschema:"objects_failed_count"`
+	SummaryReportPath string `tfschema:"summary_report_path"`
+}
+
+func (r StorageTaskAssignmentResource) ResourceType() string {
+	return "azurerm_storage_task_assignment"
+}
+
+func (r StorageTaskAssignmentResource) ModelObject() interface{} {
+	return &StorageTaskAssignmentResourceModel{}
+}
+
+func (r StorageTaskAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return storagetaskassignments.ValidateStorageTaskAssignmentID
+}
+
+func (r StorageTaskAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageTaskAssignmentName,
+		},
+
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"storage_task_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storagetasks.ValidateStorageTaskID,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"execution_trigger": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(storagetaskassignments.PossibleValuesForTriggerType(), false),
+					},
+
+					"start_on": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"start_from": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"interval_in_days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"end_by": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+				},
+			},
+		},
+
+		"report_prefix": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"target_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"target_exclude_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r StorageTaskAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"run_status": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"finish_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"run_result": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"objects_targeted_count": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"objects_succeeded_count": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"objects_failed_count": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"summary_report_path": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r StorageTaskAssignmentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model StorageTaskAssignmentResourceModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, trigger := range model.ExecutionTrigger {
+				switch storagetaskassignments.TriggerType(trigger.Type) {
+				case storagetaskassignments.TriggerTypeRunOnce:
+					if trigger.StartFrom != "" || trigger.IntervalInDays != 0 || trigger.EndBy != "" {
+						return fmt.Errorf("`start_from`, `interval_in_days` and `end_by` cannot be specified when the `execution_trigger` `type` is `%s`", trigger.Type)
+					}
+				case storagetaskassignments.TriggerTypeOnSchedule:
+					if trigger.StartOn != "" {
+						return fmt.Errorf("`start_on` cannot be specified when the `execution_trigger` `type` is `%s`", trigger.Type)
+					}
+					if trigger.StartFrom == "" || trigger.IntervalInDays == 0 || trigger.EndBy == "" {
+						return fmt.Errorf("`start_from`, `interval_in_days` and `end_by` must be specified when the `execution_trigger` `type` is `%s`", trigger.Type)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageTaskAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageTaskAssignments
+
+			var model StorageTaskAssignmentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := storagetaskassignments.NewStorageTaskAssignmentID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.StorageAccountName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := storagetaskassignments.StorageTaskAssignment{
+				Properties: storagetaskassignments.StorageTaskAssignmentProperties{
+					Description: model.Description,
+					Enabled:     model.Enabled,
+					ExecutionContext: storagetaskassignments.StorageTaskAssignmentExecutionContext{
+						Target:  expandStorageTaskAssignmentTarget(model),
+						Trigger: expandStorageTaskAssignmentTrigger(model.ExecutionTrigger),
+					},
+					Report: storagetaskassignments.StorageTaskAssignmentReport{
+						Prefix: model.ReportPrefix,
+					},
+					TaskId: model.StorageTaskId,
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageTaskAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageTaskAssignments
+
+			id, err := storagetaskassignments.ParseStorageTaskAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageTaskAssignmentResourceModel{
+				Name:             id.StorageTaskAssignmentName,
+				StorageAccountId: commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+
+				taskId, err := storagetasks.ParseStorageTaskIDInsensitively(props.TaskId)
+				if err != nil {
+					return err
+				}
+				state.StorageTaskId = taskId.ID()
+				state.Description = props.Description
+				state.Enabled = props.Enabled
+				state.ReportPrefix = props.Report.Prefix
+				state.ExecutionTrigger = flattenStorageTaskAssignmentTrigger(props.ExecutionContext.Trigger)
+				if target := props.ExecutionContext.Target; target != nil {
+					state.TargetPrefixes = pointer.From(target.Prefix)
+					state.TargetExcludePrefixes = pointer.From(target.ExcludePrefix)
+				}
+				state.RunStatus = flattenStorageTaskAssignmentRunStatus(props.RunStatus)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageTaskAssignmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageTaskAssignments
+
+			id, err := storagetaskassignments.ParseStorageTaskAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageTaskAssignmentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			props := storagetaskassignments.StorageTaskAssignmentUpdateProperties{}
+
+			if metadata.ResourceData.HasChange("description") {
+				props.Description = pointer.To(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				props.Enabled = pointer.To(model.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("report_prefix") {
+				props.Report = &storagetaskassignments.StorageTaskAssignmentUpdateReport{
+					Prefix: pointer.To(model.ReportPrefix),
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("execution_trigger", "target_prefixes", "target_exclude_prefixes") {
+				trigger := expandStorageTaskAssignmentTrigger(model.ExecutionTrigger)
+				props.ExecutionContext = &storagetaskassignments.StorageTaskAssignmentUpdateExecutionContext{
+					Target: expandStorageTaskAssignmentTarget(model),
+					Trigger: &storagetaskassignments.ExecutionTriggerUpdate{
+						Type: pointer.To(trigger.Type),
+						Parameters: &storagetaskassignments.TriggerParametersUpdate{
+							EndBy:        trigger.Parameters.EndBy,
+							Interval:     trigger.Parameters.Interval,
+							IntervalUnit: trigger.Parameters.IntervalUnit,
+							StartFrom:    trigger.Parameters.StartFrom,
+							StartOn:      trigger.Parameters.StartOn,
+						},
+					},
+				}
+			}
+
+			payload := storagetaskassignments.StorageTaskAssignmentUpdateParameters{
+				Properties: &props,
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageTaskAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageTaskAssignments
+
+			id, err := storagetaskassignments.ParseStorageTaskAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStorageTaskAssignmentTarget(input StorageTaskAssignmentResourceModel) *storagetaskassignments.ExecutionTarget {
+	if len(input.TargetPrefixes) == 0 && len(input.TargetExcludePrefixes) == 0 {
+		return nil
+	}
+
+	output := storagetaskassignments.ExecutionTarget{}
+	if len(input.TargetPrefixes) > 0 {
+		output.Prefix = pointer.To(input.TargetPrefixes)
+	}
+	if len(input.TargetExcludePrefixes) > 0 {
+		output.ExcludePrefix = pointer.To(input.TargetExcludePrefixes)
+	}
+	return &output
+}
+
+func expandStorageTaskAssignmentTrigger(input []StorageTaskAssignmentTriggerModel) storagetaskassignments.ExecutionTrigger {
+	output := storagetaskassignments.ExecutionTrigger{}
+	if len(input) == 0 {
+		return output
+	}
+
+	trigger := input[0]
+	output.Type = storagetaskassignments.TriggerType(trigger.Type)
+	if trigger.StartOn != "" {
+		output.Parameters.StartOn = pointer.To(trigger.StartOn)
+	}
+	if trigger.StartFrom != "" {
+		output.Parameters.StartFrom = pointer.To(trigger.StartFrom)
+	}
+	if trigger.IntervalInDays != 0 {
+		output.Parameters.Interval = pointer.To(trigger.IntervalInDays)
+		output.Parameters.IntervalUnit = pointer.To(storagetaskassignments.IntervalUnitDays)
+	}
+	if trigger.EndBy != "" {
+		output.Parameters.EndBy = pointer.To(trigger.EndBy)
+	}
+	return output
+}
+
+func flattenStorageTaskAssignmentTrigger(input storagetaskassignments.ExecutionTrigger) []StorageTaskAssignmentTriggerModel {
+	return []StorageTaskAssignmentTriggerModel{
+		{
+			Type:           string(input.Type),
+			StartOn:        pointer.From(input.Parameters.StartOn),
+			StartFrom:      pointer.From(input.Parameters.StartFrom),
+			IntervalInDays: pointer.From(input.Parameters.Interval),
+			EndBy:          pointer.From(input.Parameters.EndBy),
+		},
+	}
+}
+
+func flattenStorageTaskAssignmentRunStatus(input *storagetaskassignments.StorageTaskReportProperties) []StorageTaskAssignmentRunStatusModel {
+	if input == nil {
+		return []StorageTaskAssignmentRunStatusModel{}
+	}
+
+	return []StorageTaskAssignmentRunStatusModel{
+		{
+			StartTime:         pointer.From(input.StartTime),
+			FinishTime:        pointer.From(input.FinishTime),
+			RunResult:         string(pointer.From(input.RunResult)),
+			ObjectsTargeted:   pointer.From(input.ObjectsTargetedCount),
+			ObjectsSucceeded:  pointer.From(input.ObjectsSucceededCount),
+			ObjectsFailed:     pointer.From(input.ObjectFailedCount),
+			SummaryReportPath: pointer.From(input.SummaryReportPath),
+		},
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/storagetaskassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageTaskAssignmentResource struct{}
+
+func TestAccStorageTaskAssignment_runOnce(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task_assignment", "test")
+	r := StorageTaskAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.runOnce(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTaskAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task_assignment", "test")
+	r := StorageTaskAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.runOnce(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageTaskAssignment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task_assignment", "test")
+	r := StorageTaskAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.runOnce(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.onSchedule(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageTaskAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := storagetaskassignments.ParseStorageTaskAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Storage.ResourceManager.StorageTaskAssignments.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r StorageTaskAssignmentResource) runOnce(data acceptance.TestData) string {
+	startOn := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task_assignment" "test" {
+  name               = "accteststa%[2]s"
+  storage_account_id = azurerm_storage_account.test.id
+  storage_task_id    = azurerm_storage_task.test.id
+  description        = "Run the storage task once"
+  report_prefix      = "${azurerm_storage_container.reports.name}/runonce"
+
+  execution_trigger {
+    type     = "RunOnce"
+    start_on = "%[3]s"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomString, startOn)
+}
+
+func (r StorageTaskAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task_assignment" "import" {
+  name               = azurerm_storage_task_assignment.test.name
+  storage_account_id = azurerm_storage_task_assignment.test.storage_account_id
+  storage_task_id    = azurerm_storage_task_assignment.test.storage_task_id
+  description        = azurerm_storage_task_assignment.test.description
+  report_prefix      = azurerm_storage_task_assignment.test.report_prefix
+
+  execution_trigger {
+    type     = "RunOnce"
+    start_on = azurerm_storage_task_assignment.test.execution_trigger.0.start_on
+  }
+}
+`, r.runOnce(data))
+}
+
+func (r StorageTaskAssignmentResource) onSchedule(data acceptance.TestData) string {
+	startFrom := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+	endBy := time.Now().UTC().Add(30 * 24 * time.Hour).Format(time.RFC3339)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task_assignment" "test" {
+  name               = "accteststa%[2]s"
+  storage_account_id = azurerm_storage_account.test.id
+  storage_task_id    = azurerm_storage_task.test.id
+  description        = "Run the storage task weekly"
+  enabled            = false
+  report_prefix      = "${azurerm_storage_container.reports.name}/weekly"
+
+  execution_trigger {
+    type             = "OnSchedule"
+    start_from       = "%[3]s"
+    interval_in_days = 7
+    end_by           = "%[4]s"
+  }
+
+  target_prefixes         = ["logs/"]
+  target_exclude_prefixes = ["logs/keep/"]
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomString, startFrom, endBy)
+}
+
+func (StorageTaskAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "reports" {
+  name               = "reports"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_storage_task" "test" {
+  name                = "acctestst%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  description         = "Move blobs to the cool tier"
+  condition           = "[[equals(AccessTier, 'Hot')]]"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  operation {
+    name = "SetBlobTier"
+    parameters = {
+      tier = "Cool"
+    }
+  }
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Owner"
+  principal_id         = azurerm_storage_task.test.identity.0.principal_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
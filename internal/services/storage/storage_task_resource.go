@@ -0,0 +1,373 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2023-01-01/storagetasks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageTaskResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageTaskResource{}
+
+type StorageTaskResourceModel struct {
+	Name              string                                     `tfschema:"name"`
+	ResourceGroupName string                                     `tfschema:"resource_group_name"`
+	Location          string                                     `tfschema:"location"`
+	Identity          []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Description       string                                     `tfschema:"description"`
+	Enabled           bool                                       `tfschema:"enabled"`
+	Condition         string                                     `tfschema:"condition"`
+	Operation         []StorageTaskOperationModel                `tfschema:"operation"`
+	ElseOperation     []StorageTaskOperationModel                `tfschema:"else_operation"`
+	Tags              map[string]string                          `tfschema:"tags"`
+	TaskVersion       int64                                      `tfschema:"task_version"`
+}
+
+type StorageTaskOperationModel struct {
+	Name       string            `tfschema:"name"`
+	Parameters map[string]string `tfschema:"parameters"`
+	OnSuccess  string            `tfschema:"on_success"`
+	OnFailure  string            `tfschema:"on_failure"`
+}
+
+func (r StorageTaskResource) ResourceType() string {
+	return "azurerm_storage_task"
+}
+
+func (r StorageTaskResource) ModelObject() interface{} {
+	return &StorageTaskResourceModel{}
+}
+
+func (r StorageTaskResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return storagetasks.ValidateStorageTaskID
+}
+
+func (r StorageTaskResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageTaskName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityRequired(),
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"condition": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"operation": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem:     storageTaskOperationSchema(),
+		},
+
+		"else_operation": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     storageTaskOperationSchema(),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func storageTaskOperationSchema() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(storagetasks.PossibleValuesForStorageTaskOperationName(), false),
+			},
+
+			"parameters": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"on_success": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(storagetasks.OnSuccessContinue),
+				ValidateFunc: validation.StringInSlice(storagetasks.PossibleValuesForOnSuccess(), false),
+			},
+
+			"on_failure": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(storagetasks.OnFailureBreak),
+				ValidateFunc: validation.StringInSlice(storagetasks.PossibleValuesForOnFailure(), false),
+			},
+		},
+	}
+}
+
+func (r StorageTaskResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"task_version": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageTaskResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.StorageTasksClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model StorageTaskResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := storagetasks.NewStorageTaskID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := storagetasks.StorageTask{
+				Identity:   pointer.From(expandedIdentity),
+				Location:   location.Normalize(model.Location),
+				Properties: expandStorageTaskProperties(model),
+				Tags:       pointer.To(model.Tags),
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageTaskResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.StorageTasksClient
+
+			id, err := storagetasks.ParseStorageTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageTaskResourceModel{
+				Name:              id.StorageTaskName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				flattenedIdentity, err := identity.FlattenLegacySystemAndUserAssignedMapToModel(&model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = flattenedIdentity
+
+				props := model.Properties
+				state.Description = props.Description
+				state.Enabled = props.Enabled
+				state.TaskVersion = pointer.From(props.TaskVersion)
+				state.Condition = props.Action.If.Condition
+				state.Operation = flattenStorageTaskOperations(props.Action.If.Operations)
+				if props.Action.Else != nil {
+					state.ElseOperation = flattenStorageTaskOperations(props.Action.Else.Operations)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageTaskResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.StorageTasksClient
+
+			id, err := storagetasks.ParseStorageTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageTaskResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := storagetasks.StorageTaskUpdateParameters{}
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = expandedIdentity
+			}
+
+			if metadata.ResourceData.HasChanges("description", "enabled", "condition", "operation", "else_operation") {
+				properties := expandStorageTaskProperties(model)
+				payload.Properties = &storagetasks.StorageTaskUpdateProperties{
+					Action:      pointer.To(properties.Action),
+					Description: pointer.To(properties.Description),
+					Enabled:     pointer.To(properties.Enabled),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageTaskResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.StorageTasksClient
+
+			id, err := storagetasks.ParseStorageTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStorageTaskProperties(input StorageTaskResourceModel) storagetasks.StorageTaskProperties {
+	output := storagetasks.StorageTaskProperties{
+		Description: input.Description,
+		Enabled:     input.Enabled,
+		Action: storagetasks.StorageTaskAction{
+			If: storagetasks.IfCondition{
+				Condition:  input.Condition,
+				Operations: expandStorageTaskOperations(input.Operation),
+			},
+		},
+	}
+
+	if len(input.ElseOperation) > 0 {
+		output.Action.Else = &storagetasks.ElseCondition{
+			Operations: expandStorageTaskOperations(input.ElseOperation),
+		}
+	}
+
+	return output
+}
+
+func expandStorageTaskOperations(input []StorageTaskOperationModel) []storagetasks.StorageTaskOperation {
+	output := make([]storagetasks.StorageTaskOperation, 0)
+	for _, v := range input {
+		operation := storagetasks.StorageTaskOperation{
+			Name:      storagetasks.StorageTaskOperationName(v.Name),
+			OnFailure: pointer.To(storagetasks.OnFailure(v.OnFailure)),
+			OnSuccess: pointer.To(storagetasks.OnSuccess(v.OnSuccess)),
+		}
+		if len(v.Parameters) > 0 {
+			operation.Parameters = pointer.To(v.Parameters)
+		}
+		output = append(output, operation)
+	}
+	return output
+}
+
+func flattenStorageTaskOperations(input []storagetasks.StorageTaskOperation) []StorageTaskOperationModel {
+	output := make([]StorageTaskOperationModel, 0)
+	for _, v := range input {
+		onSuccess := string(storagetasks.OnSuccessContinue)
+		if v.OnSuccess != nil {
+			onSuccess = string(*v.OnSuccess)
+		}
+		onFailure := string(storagetasks.OnFailureBreak)
+		if v.OnFailure != nil {
+			onFailure = string(*v.OnFailure)
+		}
+
+		output = append(output, StorageTaskOperationModel{
+			Name:       string(v.Name),
+			Parameters: pointer.From(v.Parameters),
+			OnSuccess:  onSuccess,
+			OnFailure:  onFailure,
+		})
+	}
+	return output
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func StorageTaskName(v interface{}, _ string) (warnings []string, errors []error) {
+	input := v.(string)
+
+	if !regexp.MustCompile("^[a-z0-9]{3,18}$").MatchString(input) {
+		errors = append(errors, fmt.Errorf("storage task name %q must be between 3 and 18 characters in length and use numbers and lower-case letters only", input))
+	}
+
+	return warnings, errors
+}
+
+func StorageTaskAssignmentName(v interface{}, _ string) (warnings []string, errors []error) {
+	input := v.(string)
+
+	if !regexp.MustCompile("^[a-z0-9]{3,24}$").MatchString(input) {
+		errors = append(errors, fmt.Errorf("storage task assignment name %q must be between 3 and 24 characters in length and use numbers and lower-case letters only", input))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestStorageTaskName(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"ab", true},
+		{"abc", false},
+		{"task01", false},
+		{"Task01", true},
+		{"task-01", true},
+		{"abcdefghijklmnopqr", false},
+		{"abcdefghijklmnopqrs", true},
+	}
+
+	for _, test := range testCases {
+		_, es := StorageTaskName(test.input, "name")
+
+		if test.shouldError != (len(es) > 0) {
+			t.Fatalf("Expected validating name %q to error: %t, got %d errors", test.input, test.shouldError, len(es))
+		}
+	}
+}
+
+func TestStorageTaskAssignmentName(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"ab", true},
+		{"abc", false},
+		{"assignment01", false},
+		{"Assignment01", true},
+		{"assignment_01", true},
+		{"abcdefghijklmnopqrstuvwx", false},
+		{"abcdefghijklmnopqrstuvwxy", true},
+	}
+
+	for _, test := range testCases {
+		_, es := StorageTaskAssignmentName(test.input, "name")
+
+		if test.shouldError != (len(es) > 0) {
+			t.Fatalf("Expected validating name %q to error: %t, got %d errors", test.input, test.shouldError, len(es))
+		}
+	}
+}